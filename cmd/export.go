@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"terraform-provider-growthbook/internal/export"
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+// runExport implements the export subcommand, which writes Terraform configuration and import blocks
+// for the objects of an existing organization.
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [flags]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Generates .tf files and import blocks for the projects, environments, "+
+			"attributes, SDK connections and features of a GrowthBook organization.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	apiURL := flags.String("api-url", envOr("GROWTHBOOK_API_URL", "https://api.growthbook.io/api/v1"),
+		"GrowthBook API base URL, defaults to GROWTHBOOK_API_URL")
+	apiKey := flags.String("api-key", os.Getenv("GROWTHBOOK_API_KEY"),
+		"GrowthBook API key, defaults to GROWTHBOOK_API_KEY")
+	out := flags.String("out", ".", "directory to write the generated files to")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if *apiKey == "" {
+		return errors.New("missing GrowthBook API key, set -api-key or GROWTHBOOK_API_KEY")
+	}
+
+	client := growthbookapi.NewClient(*apiURL, *apiKey)
+	return export.Run(ctx, client, *out)
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -4,6 +4,7 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"terraform-provider-growthbook/internal"
 
@@ -11,6 +12,13 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	err := providerserver.Serve(context.Background(), internal.New, providerserver.ServeOpts{
 		Address: "registry.terraform.io/hashicorp/growthbook",
 	})
@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+// GrowthBook grants access the same way to members and teams: a global role, optionally limited to some
+// environments, overridden per project. An empty environments list gives access to every environment.
+
+type projectRoleModel struct {
+	Project      types.String `tfsdk:"project"`
+	Role         types.String `tfsdk:"role"`
+	Environments types.List   `tfsdk:"environments"`
+}
+
+func projectRoleObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"project":      types.StringType,
+		"role":         types.StringType,
+		"environments": types.ListType{ElemType: types.StringType},
+	}}
+}
+
+// accessResourceAttributes returns the role, environments and project_roles attributes of teams and member roles.
+func accessResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"role": schema.StringAttribute{
+			Required:    true,
+			Description: "The global role, e.g. 'readonly', 'collaborator', 'engineer', 'admin' or a custom role ID.",
+		},
+		"environments": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Limits the global role to these environment IDs. Empty gives access to all environments.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_roles": schema.ListNestedAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Roles overriding the global role in some projects.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"project": schema.StringAttribute{
+						Required:    true,
+						Description: "The project ID.",
+					},
+					"role": schema.StringAttribute{
+						Required:    true,
+						Description: "The role in the project.",
+					},
+					"environments": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						Description: "Limits the project role to these environment IDs.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// environmentsToAPI returns whether access is limited by environment, and to which environments.
+func environmentsToAPI(ctx context.Context, l types.List) (bool, []string, diag.Diagnostics) {
+	envs, diags := listToStrings(ctx, l)
+	return len(envs) > 0, envs, diags
+}
+
+// environmentsFromAPI returns the environments access is limited to, none when it is not limited.
+func environmentsFromAPI(ctx context.Context, limit bool, envs []string) types.List {
+	if !limit {
+		envs = nil
+	}
+	return stringsToList(ctx, envs)
+}
+
+func projectRolesToAPI(ctx context.Context, l types.List) ([]growthbookapi.ProjectRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	roles := []growthbookapi.ProjectRole{}
+	if l.IsNull() || l.IsUnknown() {
+		return roles, diags
+	}
+
+	var models []projectRoleModel
+	diags.Append(l.ElementsAs(ctx, &models, false)...)
+	for _, m := range models {
+		limit, envs, d := environmentsToAPI(ctx, m.Environments)
+		diags.Append(d...)
+		roles = append(roles, growthbookapi.ProjectRole{
+			Project:                  m.Project.ValueString(),
+			Role:                     m.Role.ValueString(),
+			LimitAccessByEnvironment: limit,
+			Environments:             envs,
+		})
+	}
+	return roles, diags
+}
+
+func projectRolesFromAPI(ctx context.Context, roles []growthbookapi.ProjectRole) (types.List, diag.Diagnostics) {
+	models := make([]projectRoleModel, len(roles))
+	for i, r := range roles {
+		models[i] = projectRoleModel{
+			Project:      types.StringValue(r.Project),
+			Role:         types.StringValue(r.Role),
+			Environments: environmentsFromAPI(ctx, r.LimitAccessByEnvironment, r.Environments),
+		}
+	}
+	return types.ListValueFrom(ctx, projectRoleObjectType(), models)
+}
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// conditionOperators lists the operators supported by GrowthBook targeting conditions.
+//
+//nolint:gochecknoglobals
+var conditionOperators = map[string]bool{
+	"$eq": true, "$ne": true, "$lt": true, "$lte": true, "$gt": true, "$gte": true,
+	"$veq": true, "$vne": true, "$vlt": true, "$vlte": true, "$vgt": true, "$vgte": true,
+	"$in": true, "$nin": true, "$ini": true, "$nini": true, "$all": true, "$alli": true,
+	"$exists": true, "$type": true, "$size": true, "$elemMatch": true,
+	"$regex": true, "$regexi": true, "$not": true,
+	"$and": true, "$or": true, "$nor": true,
+	"$inGroup": true, "$notInGroup": true,
+}
+
+// parseCondition decodes a JSON targeting condition and checks its operators.
+// An empty string is a valid, empty condition.
+func parseCondition(condition string) (map[string]any, error) {
+	if strings.TrimSpace(condition) == "" {
+		return map[string]any{}, nil
+	}
+	dec := json.NewDecoder(strings.NewReader(condition))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid JSON: unexpected data after the condition")
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("condition must be a JSON object")
+	}
+	if err := checkConditionOperators(obj, ""); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// canonicalCondition checks a condition built from Go values and encodes it as key-sorted JSON.
+func canonicalCondition(v any) (string, error) {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return "", errors.New("condition must be an object")
+	}
+	if err := checkConditionOperators(obj, ""); err != nil {
+		return "", err
+	}
+	// encoding/json sorts map keys
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// checkConditionOperators walks a condition and rejects keys starting with '$' that are not known operators.
+// path is used to locate the offending key in error messages.
+func checkConditionOperators(v any, path string) error {
+	switch val := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		// deterministic error messages
+		sort.Strings(keys)
+		for _, k := range keys {
+			if strings.HasPrefix(k, "$") && !conditionOperators[k] {
+				return fmt.Errorf("unknown operator %q at %s", k, conditionPath(path, k))
+			}
+			if err := checkConditionOperators(val[k], conditionPath(path, k)); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, e := range val {
+			if err := checkConditionOperators(e, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func conditionPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		condition string
+		wantKeys  int
+		wantErr   string
+	}{
+		{"", 0, ""},
+		{"  \n", 0, ""},
+		{`{}`, 0, ""},
+		{`{"country": "US"}`, 1, ""},
+		{`{"country": {"$in": ["US", "CA"]}, "age": {"$gte": 18}}`, 2, ""},
+		{`{"$or": [{"plan": "pro"}, {"$not": {"beta": false}}]}`, 1, ""},
+		{`{"id": {"$inGroup": "grp_123"}}`, 1, ""},
+		{`{"price": 19.99}`, 1, ""},
+		{`{"country": "US"`, 0, "invalid JSON"},
+		{`country = US`, 0, "invalid JSON"},
+		{`{"a": 1} {"b": 2}`, 0, "invalid JSON: unexpected data after the condition"},
+		{`["US"]`, 0, "condition must be a JSON object"},
+		{`"US"`, 0, "condition must be a JSON object"},
+		{`null`, 0, "condition must be a JSON object"},
+		{`{"country": {"$within": ["US"]}}`, 0, `unknown operator "$within" at country.$within`},
+	}
+	for _, tt := range tests {
+		got, err := parseCondition(tt.condition)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseCondition(%q): got error %v, want %q", tt.condition, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseCondition(%q): unexpected error %v", tt.condition, err)
+			continue
+		}
+		if len(got) != tt.wantKeys {
+			t.Errorf("parseCondition(%q): got %d keys, want %d", tt.condition, len(got), tt.wantKeys)
+		}
+	}
+}
+
+func TestCheckConditionOperators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		condition any
+		wantErr   string
+	}{
+		{"scalar", "US", ""},
+		{"unknown top-level operator", map[string]any{"country": "US", "$custom": nil},
+			`unknown operator "$custom" at $custom`},
+		{"known operators", map[string]any{
+			"version": map[string]any{"$vgte": "1.2.0"},
+			"tags":    map[string]any{"$elemMatch": map[string]any{"$regexi": "^beta"}},
+			"$and":    []any{map[string]any{"id": map[string]any{"$notInGroup": "grp_1"}}},
+		}, ""},
+		{"attribute starting with a letter", map[string]any{"dollar$": 1}, ""},
+		{"nested object", map[string]any{"user": map[string]any{"plan": map[string]any{"$is": "pro"}}},
+			`unknown operator "$is" at user.plan.$is`},
+		{"inside a list", map[string]any{"$or": []any{
+			map[string]any{"plan": "pro"},
+			map[string]any{"plan": map[string]any{"$like": "ent%"}},
+		}}, `unknown operator "$like" at $or[1].plan.$like`},
+		{"first unknown operator in key order", map[string]any{
+			"b": map[string]any{"$bad": 1},
+			"a": map[string]any{"$worse": 1},
+		}, `unknown operator "$worse" at a.$worse`},
+		{"operators are case sensitive", map[string]any{"id": map[string]any{"$InGroup": "grp_1"}},
+			`unknown operator "$InGroup" at id.$InGroup`},
+	}
+	for _, tt := range tests {
+		err := checkConditionOperators(tt.condition, "")
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
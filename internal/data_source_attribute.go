@@ -17,17 +17,32 @@ func newAttributeDataSource() datasource.DataSource {
 }
 
 type attributeDataSource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type attributeDataModel struct {
-	Property    types.String `tfsdk:"property"`
-	DataType    types.String `tfsdk:"datatype"`
-	Format      types.String `tfsdk:"format"`
-	EnumValues  types.String `tfsdk:"enum_values"`
-	Projects    types.List   `tfsdk:"projects"`
-	Archived    types.Bool   `tfsdk:"archived"`
-	Description types.String `tfsdk:"description"`
+	Property     types.String `tfsdk:"property"`
+	DataType     types.String `tfsdk:"datatype"`
+	Format       types.String `tfsdk:"format"`
+	EnumValues   types.String `tfsdk:"enum_values"`
+	Projects     types.List   `tfsdk:"projects"`
+	Archived     types.Bool   `tfsdk:"archived"`
+	Description  types.String `tfsdk:"description"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+// attributeDataFromAPI maps an attribute to the attributes of the growthbook_attribute and growthbook_attributes
+// data sources.
+func attributeDataFromAPI(ctx context.Context, attribute *growthbookapi.Attribute) attributeDataModel {
+	return attributeDataModel{
+		Property:    types.StringValue(attribute.Property),
+		DataType:    types.StringValue(attribute.DataType),
+		Format:      types.StringValue(attribute.Format),
+		EnumValues:  types.StringValue(attribute.EnumValues),
+		Projects:    stringsToList(ctx, attribute.Projects),
+		Archived:    types.BoolValue(attribute.Archived),
+		Description: types.StringValue(attribute.Description),
+	}
 }
 
 func (d *attributeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +52,7 @@ func (d *attributeDataSource) Metadata(_ context.Context, req datasource.Metadat
 func (d *attributeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"property": schema.StringAttribute{
 				Required: true,
 			},
@@ -68,12 +84,12 @@ func (d *attributeDataSource) Configure(_ context.Context, req datasource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	d.client = client
+	d.clients = clients
 }
 
 func (d *attributeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -83,19 +99,20 @@ func (d *attributeDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	attribute, err := d.client.GetAttribute(ctx, data.Property.ValueString())
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := client.GetAttribute(ctx, data.Property.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to find GrowthBook attribute by property", err.Error())
 		return
 	}
 
-	data.Property = types.StringValue(attribute.Property)
-	data.DataType = types.StringValue(attribute.DataType)
-	data.Format = types.StringValue(attribute.Format)
-	data.EnumValues = types.StringValue(attribute.EnumValues)
-	data.Projects = stringsToList(ctx, attribute.Projects)
-	data.Archived = types.BoolValue(attribute.Archived)
-	data.Description = types.StringValue(attribute.Description)
-
+	organization := data.Organization
+	data = attributeDataFromAPI(ctx, attribute)
+	data.Organization = organization
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
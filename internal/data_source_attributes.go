@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ datasource.DataSource = &attributesDataSource{}
+
+func newAttributesDataSource() datasource.DataSource {
+	return &attributesDataSource{}
+}
+
+type attributesDataSource struct {
+	clients *providerClients
+}
+
+type attributesDataModel struct {
+	DataType     types.String         `tfsdk:"datatype"`
+	Project      types.String         `tfsdk:"project"`
+	Archived     types.Bool           `tfsdk:"archived"`
+	Attributes   []attributeDataModel `tfsdk:"attributes"`
+	Organization types.String         `tfsdk:"organization"`
+}
+
+func (d *attributesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attributes"
+}
+
+func (d *attributesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the GrowthBook attributes matching all the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"datatype": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the attributes of this data type, e.g. 'string' or 'enum'.",
+			},
+			"project": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the attributes available in this project ID, including attributes of all projects.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list archived attributes when true, or active attributes when false.",
+			},
+			"attributes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching attributes, sorted by property.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(ctx, newAttributeDataSource()),
+				},
+			},
+		},
+	}
+}
+
+func (d *attributesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+// matches tells whether an attribute passes the filters set in the configuration.
+// Attributes without projects are available in every project.
+func (m *attributesDataModel) matches(a *growthbookapi.Attribute) bool {
+	switch {
+	case !m.DataType.IsNull() && a.DataType != m.DataType.ValueString():
+		return false
+	case !m.Project.IsNull() && len(a.Projects) > 0 && !slices.Contains(a.Projects, m.Project.ValueString()):
+		return false
+	case !m.Archived.IsNull() && a.Archived != m.Archived.ValueBool():
+		return false
+	}
+	return true
+}
+
+func (d *attributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data attributesDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, err := client.ListAttributes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook attributes", err.Error())
+		return
+	}
+	slices.SortFunc(attributes, func(a, b growthbookapi.Attribute) int { return strings.Compare(a.Property, b.Property) })
+
+	data.Attributes = []attributeDataModel{}
+	for i := range attributes {
+		if data.matches(&attributes[i]) {
+			item := attributeDataFromAPI(ctx, &attributes[i])
+			item.Organization = data.Organization
+			data.Attributes = append(data.Attributes, item)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -6,8 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"terraform-provider-growthbook/internal/growthbookapi"
 )
 
 var _ datasource.DataSource = &environmentDataSource{}
@@ -17,7 +15,7 @@ func newEnvironmentDataSource() datasource.DataSource {
 }
 
 type environmentDataSource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type environmentDataModel struct {
@@ -26,6 +24,7 @@ type environmentDataModel struct {
 	ToggleOnList types.Bool   `tfsdk:"toggle_on_list"`
 	DefaultState types.Bool   `tfsdk:"default_state"`
 	Projects     types.List   `tfsdk:"projects"`
+	Organization types.String `tfsdk:"organization"`
 }
 
 func (d *environmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,6 +34,7 @@ func (d *environmentDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *environmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the GrowthBook environment.",
@@ -64,12 +64,12 @@ func (d *environmentDataSource) Configure(_ context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	d.client = client
+	d.clients = clients
 }
 
 func (d *environmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -79,7 +79,13 @@ func (d *environmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	env, err := d.client.FindEnvironmentByID(ctx, data.ID.ValueString())
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, err := client.FindEnvironmentByID(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to find GrowthBook environment by ID", err.Error())
 		return
@@ -6,8 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"terraform-provider-growthbook/internal/growthbookapi"
 )
 
 var _ datasource.DataSource = &environmentsDataSource{}
@@ -17,10 +15,11 @@ func newEnvironmentsDataSource() datasource.DataSource {
 }
 
 type environmentsDataSource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type environmentsDataModel struct {
+	Organization types.String           `tfsdk:"organization"`
 	Environments []environmentDataModel `tfsdk:"environments"`
 }
 
@@ -31,10 +30,15 @@ func (d *environmentsDataSource) Metadata(_ context.Context, req datasource.Meta
 func (d *environmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"environments": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"organization": schema.StringAttribute{
+							Computed:    true,
+							Description: "The organization of the data source.",
+						},
 						"id": schema.StringAttribute{
 							Computed:    true,
 							Description: "The ID of the GrowthBook environment.",
@@ -67,24 +71,37 @@ func (d *environmentsDataSource) Configure(_ context.Context, req datasource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	d.client = client
+	d.clients = clients
 }
 
-func (d *environmentsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	envs, err := d.client.ListEnvironments(ctx)
+func (d *environmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data environmentsDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envs, err := client.ListEnvironments(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to list GrowthBook environments", err.Error())
 		return
 	}
 
-	items := make([]environmentDataModel, len(envs))
+	data.Environments = make([]environmentDataModel, len(envs))
 	for i, env := range envs {
-		items[i] = environmentDataModel{
+		data.Environments[i] = environmentDataModel{
+			Organization: data.Organization,
 			ID:           types.StringValue(env.ID),
 			Description:  types.StringValue(env.Description),
 			ToggleOnList: types.BoolValue(env.ToggleOnList),
@@ -93,5 +110,5 @@ func (d *environmentsDataSource) Read(ctx context.Context, _ datasource.ReadRequ
 		}
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &environmentsDataModel{Environments: items})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
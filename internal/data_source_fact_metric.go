@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &factMetricDataSource{}
+
+func newFactMetricDataSource() datasource.DataSource {
+	return &factMetricDataSource{}
+}
+
+type factMetricDataSource struct {
+	clients *providerClients
+}
+
+func factMetricColumnDataSourceAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"fact_table_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"column": schema.StringAttribute{
+			Computed: true,
+		},
+		"aggregation": schema.StringAttribute{
+			Computed: true,
+		},
+		"filters": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+func (d *factMetricDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fact_metric"
+}
+
+func (d *factMetricDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the fact metric.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the fact metric.",
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"owner": schema.StringAttribute{
+				Computed: true,
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"datasource": schema.StringAttribute{
+				Computed: true,
+			},
+			"metric_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "One of 'proportion', 'retention', 'mean', 'quantile' or 'ratio'.",
+			},
+			"numerator": schema.SingleNestedAttribute{
+				Computed:   true,
+				Attributes: factMetricColumnDataSourceAttrs(),
+			},
+			"denominator": schema.SingleNestedAttribute{
+				Computed:   true,
+				Attributes: factMetricColumnDataSourceAttrs(),
+			},
+			"inverse": schema.BoolAttribute{
+				Computed: true,
+			},
+			"capping_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"capping_value": schema.Float64Attribute{
+				Computed: true,
+			},
+			"window_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"window_delay_hours": schema.Float64Attribute{
+				Computed: true,
+			},
+			"window_value": schema.Float64Attribute{
+				Computed: true,
+			},
+			"window_unit": schema.StringAttribute{
+				Computed: true,
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *factMetricDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+func (d *factMetricDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// numerator is a required object in factMetricModel, so only the name and organization are read from config
+	var name, organization types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := client.ListFactMetrics(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook fact metrics", err.Error())
+		return
+	}
+
+	for _, m := range metrics {
+		if m.Name == name.ValueString() {
+			result := factMetricToModel(ctx, &m)
+			result.Organization = organization
+			resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Unable to find GrowthBook fact metric by name",
+		"No fact metric named '"+name.ValueString()+"'.")
+}
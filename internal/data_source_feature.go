@@ -18,7 +18,7 @@ func newFeatureDataSource() datasource.DataSource {
 }
 
 type featureDataSource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type featureDataModel struct {
@@ -32,6 +32,7 @@ type featureDataModel struct {
 	Tags          types.List   `tfsdk:"tags"`
 	Environments  types.Map    `tfsdk:"environments"`
 	Prerequisites types.List   `tfsdk:"prerequisites"`
+	Organization  types.String `tfsdk:"organization"`
 }
 
 func featureDataEnvironmentSchemaAttr() schema.MapNestedAttribute {
@@ -67,6 +68,18 @@ func featureDataEnvironmentSchemaAttr() schema.MapNestedAttribute {
 									},
 								},
 							},
+							"saved_group_targeting": schema.ListNestedAttribute{
+								Computed: true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"match_type": schema.StringAttribute{Computed: true},
+										"saved_groups": schema.ListAttribute{
+											ElementType: types.StringType,
+											Computed:    true,
+										},
+									},
+								},
+							},
 							"prerequisites": schema.ListNestedAttribute{
 								Computed: true,
 								NestedObject: schema.NestedAttributeObject{
@@ -84,6 +97,25 @@ func featureDataEnvironmentSchemaAttr() schema.MapNestedAttribute {
 	}
 }
 
+// featureDataFromAPI maps a feature to the attributes of the growthbook_feature and growthbook_features data sources.
+func featureDataFromAPI(ctx context.Context, feature *growthbookapi.Feature) (featureDataModel, diag.Diagnostics) {
+	data := featureDataModel{
+		ID:            types.StringValue(feature.ID),
+		Archived:      types.BoolValue(feature.Archived),
+		Description:   types.StringValue(feature.Description),
+		Owner:         types.StringValue(feature.Owner),
+		Project:       types.StringValue(feature.Project),
+		ValueType:     types.StringValue(feature.ValueType),
+		DefaultValue:  types.StringValue(feature.DefaultValue),
+		Tags:          stringsToList(ctx, feature.Tags),
+		Prerequisites: stringsToList(ctx, feature.Prerequisites),
+	}
+
+	var diags diag.Diagnostics
+	data.Environments, diags = types.MapValueFrom(ctx, featureEnvObjectType(), envsFromAPI(feature.Environments))
+	return data, diags
+}
+
 func (d *featureDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_feature"
 }
@@ -91,6 +123,7 @@ func (d *featureDataSource) Metadata(_ context.Context, req datasource.MetadataR
 func (d *featureDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the GrowthBook feature.",
@@ -130,12 +163,12 @@ func (d *featureDataSource) Configure(_ context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	d.client = client
+	d.clients = clients
 }
 
 func (d *featureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -145,26 +178,21 @@ func (d *featureDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	feature, err := d.client.GetFeature(ctx, data.ID.ValueString())
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := client.GetFeature(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to find GrowthBook feature by ID", err.Error())
 		return
 	}
 
-	data.ID = types.StringValue(feature.ID)
-	data.Archived = types.BoolValue(feature.Archived)
-	data.Description = types.StringValue(feature.Description)
-	data.Owner = types.StringValue(feature.Owner)
-	data.Project = types.StringValue(feature.Project)
-	data.ValueType = types.StringValue(feature.ValueType)
-	data.DefaultValue = types.StringValue(feature.DefaultValue)
-	data.Tags = stringsToList(ctx, feature.Tags)
-	data.Prerequisites = stringsToList(ctx, feature.Prerequisites)
-
-	envsMap := envsFromAPI(feature.Environments)
-	var envDiags diag.Diagnostics
-	data.Environments, envDiags = types.MapValueFrom(ctx, featureEnvObjectType(), envsMap)
-	resp.Diagnostics.Append(envDiags...)
-
+	organization := data.Organization
+	data, diags = featureDataFromAPI(ctx, feature)
+	data.Organization = organization
+	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ datasource.DataSource = &featuresDataSource{}
+
+func newFeaturesDataSource() datasource.DataSource {
+	return &featuresDataSource{}
+}
+
+type featuresDataSource struct {
+	clients *providerClients
+}
+
+type featuresDataModel struct {
+	Project      types.String       `tfsdk:"project"`
+	Tag          types.String       `tfsdk:"tag"`
+	Owner        types.String       `tfsdk:"owner"`
+	Archived     types.Bool         `tfsdk:"archived"`
+	ValueType    types.String       `tfsdk:"value_type"`
+	IDPrefix     types.String       `tfsdk:"id_prefix"`
+	Features     []featureDataModel `tfsdk:"features"`
+	Organization types.String       `tfsdk:"organization"`
+}
+
+func (d *featuresDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_features"
+}
+
+func (d *featuresDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the GrowthBook features matching all the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"project": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the features of this project ID.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the features with this tag.",
+			},
+			"owner": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the features of this owner.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list archived features when true, or active features when false.",
+			},
+			"value_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the features of this value type.",
+				Validators: []validator.String{
+					stringOneOf("boolean", "number", "string", "json"),
+				},
+			},
+			"id_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the features whose ID starts with this prefix.",
+			},
+			"features": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching features, sorted by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"organization":  schema.StringAttribute{Computed: true},
+						"id":            schema.StringAttribute{Computed: true},
+						"archived":      schema.BoolAttribute{Computed: true},
+						"description":   schema.StringAttribute{Computed: true},
+						"owner":         schema.StringAttribute{Computed: true},
+						"project":       schema.StringAttribute{Computed: true},
+						"value_type":    schema.StringAttribute{Computed: true},
+						"default_value": schema.StringAttribute{Computed: true},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"environments": featureDataEnvironmentSchemaAttr(),
+						"prerequisites": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featuresDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+// matches tells whether a feature passes the filters set in the configuration.
+func (m *featuresDataModel) matches(f *growthbookapi.Feature) bool {
+	switch {
+	case !m.Project.IsNull() && f.Project != m.Project.ValueString():
+		return false
+	case !m.Tag.IsNull() && !slices.Contains(f.Tags, m.Tag.ValueString()):
+		return false
+	case !m.Owner.IsNull() && f.Owner != m.Owner.ValueString():
+		return false
+	case !m.Archived.IsNull() && f.Archived != m.Archived.ValueBool():
+		return false
+	case !m.ValueType.IsNull() && f.ValueType != m.ValueType.ValueString():
+		return false
+	case !m.IDPrefix.IsNull() && !strings.HasPrefix(f.ID, m.IDPrefix.ValueString()):
+		return false
+	}
+	return true
+}
+
+func (d *featuresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data featuresDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	features, err := client.ListFeatures(ctx, growthbookapi.ListFilter{ProjectID: data.Project.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook features", err.Error())
+		return
+	}
+	slices.SortFunc(features, func(a, b growthbookapi.Feature) int { return strings.Compare(a.ID, b.ID) })
+
+	data.Features = []featureDataModel{}
+	for i := range features {
+		if !data.matches(&features[i]) {
+			continue
+		}
+		item, diags := featureDataFromAPI(ctx, &features[i])
+		resp.Diagnostics.Append(diags...)
+		item.Organization = data.Organization
+		data.Features = append(data.Features, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &membersDataSource{}
+
+func newMembersDataSource() datasource.DataSource {
+	return &membersDataSource{}
+}
+
+type membersDataSource struct {
+	clients *providerClients
+}
+
+type membersDataModel struct {
+	Organization types.String      `tfsdk:"organization"`
+	Members      []memberDataModel `tfsdk:"members"`
+}
+
+type memberDataModel struct {
+	Organization types.String `tfsdk:"organization"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Email        types.String `tfsdk:"email"`
+	Role         types.String `tfsdk:"role"`
+	Environments types.List   `tfsdk:"environments"`
+	ProjectRoles types.List   `tfsdk:"project_roles"`
+	Teams        types.List   `tfsdk:"teams"`
+}
+
+func (d *membersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_members"
+}
+
+func (d *membersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the members of the GrowthBook organization.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"members": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"organization": schema.StringAttribute{
+							Computed:    true,
+							Description: "The organization of the data source.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The user ID of the member.",
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The global role of the member.",
+						},
+						"environments": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The environments the global role is limited to, empty when not limited.",
+						},
+						"project_roles": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"project": schema.StringAttribute{Computed: true},
+									"role":    schema.StringAttribute{Computed: true},
+									"environments": schema.ListAttribute{
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"teams": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The IDs of the teams of the member.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *membersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+func (d *membersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data membersDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := client.ListMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook members", err.Error())
+		return
+	}
+
+	data.Members = make([]memberDataModel, len(members))
+	for i, m := range members {
+		projectRoles, diags := projectRolesFromAPI(ctx, m.ProjectRoles)
+		resp.Diagnostics.Append(diags...)
+		data.Members[i] = memberDataModel{
+			Organization: data.Organization,
+			ID:           types.StringValue(m.ID),
+			Name:         types.StringValue(m.Name),
+			Email:        types.StringValue(m.Email),
+			Role:         types.StringValue(m.Role),
+			Environments: environmentsFromAPI(ctx, m.LimitAccessByEnvironment, m.Environments),
+			ProjectRoles: projectRoles,
+			Teams:        stringsToList(ctx, m.Teams),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &metricDataSource{}
+
+func newMetricDataSource() datasource.DataSource {
+	return &metricDataSource{}
+}
+
+type metricDataSource struct {
+	clients *providerClients
+}
+
+func (d *metricDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric"
+}
+
+func (d *metricDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the metric.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the metric.",
+			},
+			"datasource_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "One of 'binomial', 'count', 'duration' or 'revenue'.",
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"owner": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Computed: true,
+			},
+			"goal": schema.StringAttribute{
+				Computed: true,
+			},
+			"identifier_types": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"conversion_sql": schema.StringAttribute{
+				Computed: true,
+			},
+			"user_aggregation_sql": schema.StringAttribute{
+				Computed: true,
+			},
+			"denominator_metric_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"capping_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"capping_value": schema.Float64Attribute{
+				Computed: true,
+			},
+			"window_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"window_delay_hours": schema.Float64Attribute{
+				Computed: true,
+			},
+			"window_value": schema.Float64Attribute{
+				Computed: true,
+			},
+			"window_unit": schema.StringAttribute{
+				Computed: true,
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *metricDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+func (d *metricDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data metricModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := client.ListMetrics(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook metrics", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	for _, m := range metrics {
+		if m.Name == name {
+			result := metricToModel(ctx, &m)
+			result.Organization = data.Organization
+			resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Unable to find GrowthBook metric by name", "No metric named '"+name+"'.")
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &organizationSettingsDataSource{}
+
+func newOrganizationSettingsDataSource() datasource.DataSource {
+	return &organizationSettingsDataSource{}
+}
+
+type organizationSettingsDataSource struct {
+	clients *providerClients
+}
+
+func (d *organizationSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (d *organizationSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the settings of a GrowthBook organization.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"stats_engine": schema.StringAttribute{
+				Computed:    true,
+				Description: "The default stats engine of experiments.",
+			},
+			"confidence_level": schema.Float64Attribute{
+				Computed: true,
+			},
+			"require_reviews": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Which feature changes need an approved review before being published.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"require_review_on":      schema.BoolAttribute{Computed: true},
+						"reset_review_on_change": schema.BoolAttribute{Computed: true},
+						"environments": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"projects": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"use_sticky_bucketing": schema.BoolAttribute{
+				Computed: true,
+			},
+			"use_fallback_attributes": schema.BoolAttribute{
+				Computed: true,
+			},
+			"secure_attribute_salt": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"feature_key_example": schema.StringAttribute{
+				Computed: true,
+			},
+			"feature_key_regex": schema.StringAttribute{
+				Computed:    true,
+				Description: "The regular expression new feature keys must match.",
+			},
+		},
+	}
+}
+
+func (d *organizationSettingsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+func (d *organizationSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data organizationSettingsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := client.GetOrganizationSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read GrowthBook organization settings", err.Error())
+		return
+	}
+
+	result, diags := organizationSettingsToModel(ctx, data, settings)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
@@ -17,16 +17,29 @@ func newProjectDataSource() datasource.DataSource {
 }
 
 type projectDataSource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type projectDataModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	StatsEngine types.String `tfsdk:"stats_engine"`
-	DateCreated types.String `tfsdk:"date_created"`
-	DateUpdated types.String `tfsdk:"date_updated"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	StatsEngine  types.String `tfsdk:"stats_engine"`
+	DateCreated  types.String `tfsdk:"date_created"`
+	DateUpdated  types.String `tfsdk:"date_updated"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+// projectDataFromAPI maps a project to the attributes of the growthbook_project and growthbook_projects data sources.
+func projectDataFromAPI(project *growthbookapi.Project) projectDataModel {
+	return projectDataModel{
+		ID:          types.StringValue(project.ID),
+		Name:        types.StringValue(project.Name),
+		Description: types.StringValue(project.Description),
+		StatsEngine: types.StringValue(project.Settings.StatsEngine),
+		DateCreated: types.StringValue(project.DateCreated),
+		DateUpdated: types.StringValue(project.DateUpdated),
+	}
 }
 
 func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,6 +49,7 @@ func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataR
 func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the GrowthBook project.",
@@ -68,12 +82,12 @@ func (d *projectDataSource) Configure(_ context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	d.client = client
+	d.clients = clients
 }
 
 func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -83,18 +97,20 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	project, err := d.client.FindProjectByName(ctx, data.Name.ValueString())
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := client.FindProjectByName(ctx, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to find GrowthBook project by name", err.Error())
 		return
 	}
 
-	data.ID = types.StringValue(project.ID)
-	data.Name = types.StringValue(project.Name)
-	data.Description = types.StringValue(project.Description)
-	data.StatsEngine = types.StringValue(project.Settings.StatsEngine)
-	data.DateCreated = types.StringValue(project.DateCreated)
-	data.DateUpdated = types.StringValue(project.DateUpdated)
-
+	organization := data.Organization
+	data = projectDataFromAPI(project)
+	data.Organization = organization
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ datasource.DataSource = &projectsDataSource{}
+
+func newProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+type projectsDataSource struct {
+	clients *providerClients
+}
+
+type projectsDataModel struct {
+	Organization types.String       `tfsdk:"organization"`
+	Projects     []projectDataModel `tfsdk:"projects"`
+}
+
+// listItemAttributes returns the attributes of a singular data source as computed attributes, to describe the items
+// of the matching list data source, e.g. growthbook_projects from growthbook_project.
+func listItemAttributes(ctx context.Context, ds datasource.DataSource) map[string]schema.Attribute {
+	var resp datasource.SchemaResponse
+	ds.Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	attrs := make(map[string]schema.Attribute, len(resp.Schema.Attributes))
+	for name, a := range resp.Schema.Attributes {
+		// lookup arguments of the singular data source are the only required attributes,
+		// the organization is the only optional one and is copied from the list data source
+		if s, ok := a.(schema.StringAttribute); ok && (s.Required || s.Optional) {
+			s.Required = false
+			s.Optional = false
+			s.Computed = true
+			a = s
+		}
+		attrs[name] = a
+	}
+	return attrs
+}
+
+func (d *projectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *projectsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all the GrowthBook projects.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The projects, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(ctx, newProjectDataSource()),
+				},
+			},
+		},
+	}
+}
+
+func (d *projectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data projectsDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook projects", err.Error())
+		return
+	}
+	slices.SortFunc(projects, func(a, b growthbookapi.Project) int { return strings.Compare(a.Name, b.Name) })
+
+	data.Projects = make([]projectDataModel, len(projects))
+	for i := range projects {
+		data.Projects[i] = projectDataFromAPI(&projects[i])
+		data.Projects[i].Organization = data.Organization
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &savedGroupDataSource{}
+
+func newSavedGroupDataSource() datasource.DataSource {
+	return &savedGroupDataSource{}
+}
+
+type savedGroupDataSource struct {
+	clients *providerClients
+}
+
+func (d *savedGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saved_group"
+}
+
+func (d *savedGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the saved group.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the saved group.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Either 'condition' or 'list'.",
+			},
+			"condition": schema.StringAttribute{
+				Computed: true,
+			},
+			"attribute_key": schema.StringAttribute{
+				Computed: true,
+			},
+			"values": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Array of project IDs.",
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *savedGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+func (d *savedGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data savedGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := client.ListSavedGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook saved groups", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	for _, g := range groups {
+		if g.Name == name {
+			result := savedGroupToModel(ctx, &g)
+			result.Organization = data.Organization
+			resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Unable to find GrowthBook saved group by name", "No saved group named '"+name+"'.")
+}
@@ -17,34 +17,84 @@ func newSDKConnectionDataSource() datasource.DataSource {
 }
 
 type sdkConnectionDataSource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
-type sdkConnectionDataModel struct {
+// sdkConnectionItemModel maps the attributes of an SDK connection listed by growthbook_sdk_connections, which leaves
+// out its keys.
+type sdkConnectionItemModel struct {
 	ID                          types.String `tfsdk:"id"`
 	Name                        types.String `tfsdk:"name"`
-	Organization                types.String `tfsdk:"organization"`
+	OrganizationID              types.String `tfsdk:"organization_id"`
 	Language                    types.String `tfsdk:"language"`
 	SdkVersion                  types.String `tfsdk:"sdk_version"`
 	Environment                 types.String `tfsdk:"environment"`
 	Projects                    types.List   `tfsdk:"projects"`
 	EncryptPayload              types.Bool   `tfsdk:"encrypt_payload"`
-	EncryptionKey               types.String `tfsdk:"encryption_key"`
 	IncludeVisualExperiments    types.Bool   `tfsdk:"include_visual_experiments"`
 	IncludeDraftExperiments     types.Bool   `tfsdk:"include_draft_experiments"`
 	IncludeExperimentNames      types.Bool   `tfsdk:"include_experiment_names"`
 	IncludeRedirectExperiments  types.Bool   `tfsdk:"include_redirect_experiments"`
 	IncludeRuleIDs              types.Bool   `tfsdk:"include_rule_ids"`
-	Key                         types.String `tfsdk:"key"`
 	ProxyEnabled                types.Bool   `tfsdk:"proxy_enabled"`
 	ProxyHost                   types.String `tfsdk:"proxy_host"`
-	ProxySigningKey             types.String `tfsdk:"proxy_signing_key"`
 	SseEnabled                  types.Bool   `tfsdk:"sse_enabled"`
 	HashSecureAttributes        types.Bool   `tfsdk:"hash_secure_attributes"`
 	RemoteEvalEnabled           types.Bool   `tfsdk:"remote_eval_enabled"`
 	SavedGroupReferencesEnabled types.Bool   `tfsdk:"saved_group_references_enabled"`
 	DateCreated                 types.String `tfsdk:"date_created"`
 	DateUpdated                 types.String `tfsdk:"date_updated"`
+	Organization                types.String `tfsdk:"organization"`
+}
+
+type sdkConnectionDataModel struct {
+	sdkConnectionItemModel
+	EncryptionKey   types.String `tfsdk:"encryption_key"`
+	Key             types.String `tfsdk:"key"`
+	ProxySigningKey types.String `tfsdk:"proxy_signing_key"`
+}
+
+// omitSecrets clears the keys of the connection, for providers configured with omit_sdk_connection_secrets.
+func (m *sdkConnectionDataModel) omitSecrets() {
+	m.Key = types.StringNull()
+	m.ProxySigningKey = types.StringNull()
+	m.EncryptionKey = types.StringNull()
+}
+
+// sdkConnectionSecretAttributes are the attributes of the growthbook_sdk_connection data source holding keys.
+var sdkConnectionSecretAttributes = []string{"encryption_key", "key", "proxy_signing_key"}
+
+// sdkConnectionDataFromAPI maps an SDK connection to the attributes of the growthbook_sdk_connection and
+// growthbook_sdk_connections data sources.
+func sdkConnectionDataFromAPI(ctx context.Context, conn *growthbookapi.SDKConnection) sdkConnectionDataModel {
+	return sdkConnectionDataModel{
+		sdkConnectionItemModel: sdkConnectionItemModel{
+			ID:                          types.StringValue(conn.ID),
+			Name:                        types.StringValue(conn.Name),
+			OrganizationID:              types.StringValue(conn.Organization),
+			Language:                    types.StringValue(conn.Language),
+			SdkVersion:                  types.StringValue(conn.SdkVersion),
+			Environment:                 types.StringValue(conn.Environment),
+			Projects:                    stringsToList(ctx, conn.Projects),
+			EncryptPayload:              types.BoolValue(conn.EncryptPayload),
+			IncludeVisualExperiments:    types.BoolValue(conn.IncludeVisualExperiments),
+			IncludeDraftExperiments:     types.BoolValue(conn.IncludeDraftExperiments),
+			IncludeExperimentNames:      types.BoolValue(conn.IncludeExperimentNames),
+			IncludeRedirectExperiments:  types.BoolValue(conn.IncludeRedirectExperiments),
+			IncludeRuleIDs:              types.BoolValue(conn.IncludeRuleIDs),
+			ProxyEnabled:                types.BoolValue(conn.ProxyEnabled),
+			ProxyHost:                   types.StringValue(conn.ProxyHost),
+			SseEnabled:                  types.BoolValue(conn.SseEnabled),
+			HashSecureAttributes:        types.BoolValue(conn.HashSecureAttributes),
+			RemoteEvalEnabled:           types.BoolValue(conn.RemoteEvalEnabled),
+			SavedGroupReferencesEnabled: types.BoolValue(conn.SavedGroupReferencesEnabled),
+			DateCreated:                 types.StringValue(conn.DateCreated),
+			DateUpdated:                 types.StringValue(conn.DateUpdated),
+		},
+		EncryptionKey:   types.StringValue(conn.EncryptionKey),
+		Key:             types.StringValue(conn.Key),
+		ProxySigningKey: types.StringValue(conn.ProxySigningKey),
+	}
 }
 
 func (d *sdkConnectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -54,6 +104,7 @@ func (d *sdkConnectionDataSource) Metadata(_ context.Context, req datasource.Met
 func (d *sdkConnectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the SDK Connection.",
@@ -62,9 +113,9 @@ func (d *sdkConnectionDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Computed:    true,
 				Description: "The unique identifier for the SDK Connection.",
 			},
-			"organization": schema.StringAttribute{
+			"organization_id": schema.StringAttribute{
 				Computed:    true,
-				Description: "The organization associated with the SDK Connection.",
+				Description: "The ID of the GrowthBook organization of the SDK Connection.",
 			},
 			"language": schema.StringAttribute{
 				Computed:    true,
@@ -160,12 +211,12 @@ func (d *sdkConnectionDataSource) Configure(_ context.Context, req datasource.Co
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	d.client = client
+	d.clients = clients
 }
 
 func (d *sdkConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -175,36 +226,23 @@ func (d *sdkConnectionDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	conn, err := d.client.FindSDKConnectionByName(ctx, data.Name.ValueString())
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := client.FindSDKConnectionByName(ctx, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SDK connection", err.Error())
 		return
 	}
 
-	data.ID = types.StringValue(conn.ID)
-	data.Name = types.StringValue(conn.Name)
-	data.Organization = types.StringValue(conn.Organization)
-	data.Language = types.StringValue(conn.Language)
-	data.SdkVersion = types.StringValue(conn.SdkVersion)
-	data.Environment = types.StringValue(conn.Environment)
-	data.Projects = stringsToList(ctx, conn.Projects)
-	data.EncryptPayload = types.BoolValue(conn.EncryptPayload)
-	data.EncryptionKey = types.StringValue(conn.EncryptionKey)
-	data.IncludeVisualExperiments = types.BoolValue(conn.IncludeVisualExperiments)
-	data.IncludeDraftExperiments = types.BoolValue(conn.IncludeDraftExperiments)
-	data.IncludeExperimentNames = types.BoolValue(conn.IncludeExperimentNames)
-	data.IncludeRedirectExperiments = types.BoolValue(conn.IncludeRedirectExperiments)
-	data.IncludeRuleIDs = types.BoolValue(conn.IncludeRuleIDs)
-	data.Key = types.StringValue(conn.Key)
-	data.ProxyEnabled = types.BoolValue(conn.ProxyEnabled)
-	data.ProxyHost = types.StringValue(conn.ProxyHost)
-	data.ProxySigningKey = types.StringValue(conn.ProxySigningKey)
-	data.SseEnabled = types.BoolValue(conn.SseEnabled)
-	data.HashSecureAttributes = types.BoolValue(conn.HashSecureAttributes)
-	data.RemoteEvalEnabled = types.BoolValue(conn.RemoteEvalEnabled)
-	data.SavedGroupReferencesEnabled = types.BoolValue(conn.SavedGroupReferencesEnabled)
-	data.DateCreated = types.StringValue(conn.DateCreated)
-	data.DateUpdated = types.StringValue(conn.DateUpdated)
-
+	organization := data.Organization
+	data = sdkConnectionDataFromAPI(ctx, conn)
+	data.Organization = organization
+	if d.clients.omitSDKConnectionSecrets {
+		data.omitSecrets()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ datasource.DataSource = &sdkConnectionsDataSource{}
+
+func newSDKConnectionsDataSource() datasource.DataSource {
+	return &sdkConnectionsDataSource{}
+}
+
+type sdkConnectionsDataSource struct {
+	clients *providerClients
+}
+
+type sdkConnectionsDataModel struct {
+	Environment    types.String             `tfsdk:"environment"`
+	Language       types.String             `tfsdk:"language"`
+	Project        types.String             `tfsdk:"project"`
+	SDKConnections []sdkConnectionItemModel `tfsdk:"sdk_connections"`
+	Organization   types.String             `tfsdk:"organization"`
+}
+
+func (d *sdkConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdk_connections"
+}
+
+func (d *sdkConnectionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// the keys of every connection would end up in the state, they are read with the growthbook_sdk_connection
+	// data source or the growthbook_sdk_connection_secrets ephemeral resource instead
+	itemAttrs := listItemAttributes(ctx, newSDKConnectionDataSource())
+	for _, name := range sdkConnectionSecretAttributes {
+		delete(itemAttrs, name)
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the GrowthBook SDK connections matching all the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationDataSourceAttribute(),
+			"environment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the SDK connections of this environment.",
+			},
+			"language": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the SDK connections using this SDK language.",
+			},
+			"project": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the SDK connections including this project ID.",
+			},
+			"sdk_connections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching SDK connections, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *sdkConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	d.clients = clients
+}
+
+// matches tells whether an SDK connection passes the filters set in the configuration.
+func (m *sdkConnectionsDataModel) matches(c *growthbookapi.SDKConnection) bool {
+	switch {
+	case !m.Environment.IsNull() && c.Environment != m.Environment.ValueString():
+		return false
+	case !m.Language.IsNull() && c.Language != m.Language.ValueString() &&
+		!slices.Contains(c.Languages, m.Language.ValueString()):
+		return false
+	case !m.Project.IsNull() && !slices.Contains(c.Projects, m.Project.ValueString()):
+		return false
+	}
+	return true
+}
+
+func (d *sdkConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sdkConnectionsDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conns, err := client.ListSDKConnections(ctx, growthbookapi.ListFilter{ProjectID: data.Project.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list GrowthBook SDK connections", err.Error())
+		return
+	}
+	slices.SortFunc(conns, func(a, b growthbookapi.SDKConnection) int { return strings.Compare(a.Name, b.Name) })
+
+	data.SDKConnections = []sdkConnectionItemModel{}
+	for i := range conns {
+		if data.matches(&conns[i]) {
+			item := sdkConnectionDataFromAPI(ctx, &conns[i]).sdkConnectionItemModel
+			item.Organization = data.Organization
+			data.SDKConnections = append(data.SDKConnections, item)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &sdkConnectionSecretsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &sdkConnectionSecretsEphemeralResource{}
+
+func newSDKConnectionSecretsEphemeralResource() ephemeral.EphemeralResource {
+	return &sdkConnectionSecretsEphemeralResource{}
+}
+
+// sdkConnectionSecretsEphemeralResource reads the keys of an SDK connection without storing them in the state or plan.
+type sdkConnectionSecretsEphemeralResource struct {
+	clients *providerClients
+}
+
+type sdkConnectionSecretsModel struct {
+	ID              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	EncryptionKey   types.String `tfsdk:"encryption_key"`
+	ProxySigningKey types.String `tfsdk:"proxy_signing_key"`
+	Organization    types.String `tfsdk:"organization"`
+}
+
+func (e *sdkConnectionSecretsEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sdk_connection_secrets"
+}
+
+func (e *sdkConnectionSecretsEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the keys of a GrowthBook SDK connection during a Terraform run, without storing them.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationEphemeralAttribute(),
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the GrowthBook SDK connection.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client key of the SDK connection.",
+			},
+			"encryption_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to decrypt the payload, empty unless the payload is encrypted.",
+			},
+			"proxy_signing_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key the GrowthBook proxy uses to sign its requests.",
+			},
+		},
+	}
+}
+
+func (e *sdkConnectionSecretsEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	e.clients = clients
+}
+
+func (e *sdkConnectionSecretsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data sdkConnectionSecretsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := e.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := client.GetSDKConnection(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SDK connection", err.Error())
+		return
+	}
+
+	data.Key = types.StringValue(conn.Key)
+	data.EncryptionKey = types.StringValue(conn.EncryptionKey)
+	data.ProxySigningKey = types.StringValue(conn.ProxySigningKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,391 @@
+// Package export generates Terraform configuration for the objects of an existing GrowthBook organization,
+// so that they can be brought under Terraform management with import blocks.
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+// Resource types of the exported objects.
+const (
+	typeProject       = "growthbook_project"
+	typeEnvironment   = "growthbook_environment"
+	typeAttribute     = "growthbook_attribute"
+	typeSDKConnection = "growthbook_sdk_connection"
+	typeFeature       = "growthbook_feature"
+)
+
+// Run lists the projects, environments, attributes, SDK connections and features of the organization and
+// writes one .tf file per resource type to dir, plus imports.tf with an import block for every resource.
+// Resources refer to each other by address when the referenced object is exported too.
+func Run(ctx context.Context, client growthbookapi.ClientAPI, dir string) error {
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("listing projects: %w", err)
+	}
+	envs, err := client.ListEnvironments(ctx)
+	if err != nil {
+		return fmt.Errorf("listing environments: %w", err)
+	}
+	attributes, err := client.ListAttributes(ctx)
+	if err != nil {
+		return fmt.Errorf("listing attributes: %w", err)
+	}
+	sdks, err := client.ListSDKConnections(ctx, growthbookapi.ListFilter{})
+	if err != nil {
+		return fmt.Errorf("listing SDK connections: %w", err)
+	}
+	features, err := client.ListFeatures(ctx, growthbookapi.ListFilter{})
+	if err != nil {
+		return fmt.Errorf("listing features: %w", err)
+	}
+
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	sort.Slice(envs, func(i, j int) bool { return envs[i].ID < envs[j].ID })
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Property < attributes[j].Property })
+	sort.Slice(sdks, func(i, j int) bool { return sdks[i].ID < sdks[j].ID })
+	sort.Slice(features, func(i, j int) bool { return features[i].ID < features[j].ID })
+
+	g := newGenerator()
+	for _, p := range projects {
+		g.project(p)
+	}
+	for _, e := range envs {
+		g.environment(e)
+	}
+	for _, a := range attributes {
+		g.attribute(a)
+	}
+	for _, s := range sdks {
+		g.sdkConnection(s)
+	}
+	// labels first, features may refer to each other as prerequisites
+	for _, f := range features {
+		g.label(typeFeature, f.ID, f.ID)
+	}
+	for _, f := range features {
+		g.feature(f)
+	}
+	return g.write(dir)
+}
+
+// generator accumulates the generated blocks by resource type.
+type generator struct {
+	// labels maps resource types to object IDs to resource labels.
+	labels map[string]map[string]string
+	// used holds the labels already taken, by resource type.
+	used    map[string]map[string]bool
+	files   map[string]*hclwrite.File
+	imports *hclwrite.File
+}
+
+func newGenerator() *generator {
+	return &generator{
+		labels:  map[string]map[string]string{},
+		used:    map[string]map[string]bool{},
+		files:   map[string]*hclwrite.File{},
+		imports: hclwrite.NewEmptyFile(),
+	}
+}
+
+// label returns the resource label of an object, derived from name the first time it is called for the object.
+func (g *generator) label(resourceType, id, name string) string {
+	if l, ok := g.labels[resourceType][id]; ok {
+		return l
+	}
+	if g.labels[resourceType] == nil {
+		g.labels[resourceType] = map[string]string{}
+		g.used[resourceType] = map[string]bool{}
+	}
+	base := resourceLabel(name)
+	l := base
+	for i := 2; g.used[resourceType][l]; i++ {
+		l = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.labels[resourceType][id] = l
+	g.used[resourceType][l] = true
+	return l
+}
+
+// resourceLabel turns a name into a valid Terraform identifier, e.g. "My Project" into "my_project".
+func resourceLabel(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)), r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	l := strings.Trim(b.String(), "_")
+	if l == "" || !unicode.IsLetter(rune(l[0])) {
+		l = "r_" + l
+	}
+	return l
+}
+
+// ref returns a reference to the attribute of an exported object, or the raw value when it is not exported.
+func (g *generator) ref(resourceType, id, attr string) hclwrite.Tokens {
+	l, ok := g.labels[resourceType][id]
+	if !ok {
+		return hclwrite.TokensForValue(cty.StringVal(id))
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: l},
+		hcl.TraverseAttr{Name: attr},
+	})
+}
+
+func (g *generator) refs(resourceType string, ids []string, attr string) hclwrite.Tokens {
+	elems := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range ids {
+		elems = append(elems, g.ref(resourceType, id, attr))
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// block appends a resource block and its import block, and returns the body of the resource block.
+func (g *generator) block(resourceType, label, importID string) *hclwrite.Body {
+	f, ok := g.files[resourceType]
+	if !ok {
+		f = hclwrite.NewEmptyFile()
+		g.files[resourceType] = f
+	} else {
+		f.Body().AppendNewline()
+	}
+
+	imports := g.imports.Body()
+	if len(imports.Blocks()) > 0 {
+		imports.AppendNewline()
+	}
+	imp := imports.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	imp.SetAttributeValue("id", cty.StringVal(importID))
+
+	return f.Body().AppendNewBlock("resource", []string{resourceType, label}).Body()
+}
+
+func setString(b *hclwrite.Body, name, v string) {
+	if v != "" {
+		b.SetAttributeValue(name, cty.StringVal(v))
+	}
+}
+
+func setBool(b *hclwrite.Body, name string, v bool) {
+	if v {
+		b.SetAttributeValue(name, cty.True)
+	}
+}
+
+func stringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	elems := make([]cty.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, cty.StringVal(v))
+	}
+	return cty.ListVal(elems)
+}
+
+func (g *generator) project(p growthbookapi.Project) {
+	b := g.block(typeProject, g.label(typeProject, p.ID, p.Name), p.ID)
+	b.SetAttributeValue("name", cty.StringVal(p.Name))
+	setString(b, "description", p.Description)
+	setString(b, "stats_engine", p.Settings.StatsEngine)
+}
+
+func (g *generator) environment(e growthbookapi.Environment) {
+	b := g.block(typeEnvironment, g.label(typeEnvironment, e.ID, e.ID), e.ID)
+	b.SetAttributeValue("name", cty.StringVal(e.ID))
+	setString(b, "description", e.Description)
+	b.SetAttributeValue("toggle_on_list", cty.BoolVal(e.ToggleOnList))
+	b.SetAttributeValue("default_state", cty.BoolVal(e.DefaultState))
+	if len(e.Projects) > 0 {
+		b.SetAttributeRaw("projects", g.refs(typeProject, e.Projects, "id"))
+	}
+}
+
+func (g *generator) attribute(a growthbookapi.Attribute) {
+	b := g.block(typeAttribute, g.label(typeAttribute, a.Property, a.Property), a.Property)
+	b.SetAttributeValue("property", cty.StringVal(a.Property))
+	b.SetAttributeValue("datatype", cty.StringVal(a.DataType))
+	setString(b, "format", a.Format)
+	setString(b, "enum_values", a.EnumValues)
+	if len(a.Projects) > 0 {
+		b.SetAttributeRaw("projects", g.refs(typeProject, a.Projects, "id"))
+	}
+	setBool(b, "archived", a.Archived)
+	setString(b, "description", a.Description)
+}
+
+func (g *generator) sdkConnection(s growthbookapi.SDKConnection) {
+	b := g.block(typeSDKConnection, g.label(typeSDKConnection, s.ID, s.Name), s.ID)
+	b.SetAttributeValue("name", cty.StringVal(s.Name))
+	b.SetAttributeValue("language", cty.StringVal(s.Language))
+	b.SetAttributeRaw("environment", g.ref(typeEnvironment, s.Environment, "id"))
+	setString(b, "sdk_version", s.SdkVersion)
+	if len(s.Projects) > 0 {
+		b.SetAttributeRaw("projects", g.refs(typeProject, s.Projects, "id"))
+	}
+	setBool(b, "encrypt_payload", s.EncryptPayload)
+	setBool(b, "include_visual_experiments", s.IncludeVisualExperiments)
+	setBool(b, "include_draft_experiments", s.IncludeDraftExperiments)
+	setBool(b, "include_experiment_names", s.IncludeExperimentNames)
+	setBool(b, "include_redirect_experiments", s.IncludeRedirectExperiments)
+	setBool(b, "include_rule_ids", s.IncludeRuleIDs)
+	setBool(b, "proxy_enabled", s.ProxyEnabled)
+	setString(b, "proxy_host", s.ProxyHost)
+	setBool(b, "hash_secure_attributes", s.HashSecureAttributes)
+	setBool(b, "remote_eval_enabled", s.RemoteEvalEnabled)
+	setBool(b, "saved_group_references_enabled", s.SavedGroupReferencesEnabled)
+}
+
+func (g *generator) feature(f growthbookapi.Feature) {
+	b := g.block(typeFeature, g.label(typeFeature, f.ID, f.ID), f.ID)
+	b.SetAttributeValue("name", cty.StringVal(f.ID))
+	setBool(b, "archived", f.Archived)
+	setString(b, "description", f.Description)
+	b.SetAttributeValue("owner", cty.StringVal(f.Owner))
+	if f.Project != "" {
+		b.SetAttributeRaw("project", g.ref(typeProject, f.Project, "id"))
+	}
+	b.SetAttributeValue("value_type", cty.StringVal(f.ValueType))
+	b.SetAttributeValue("default_value", cty.StringVal(f.DefaultValue))
+	if len(f.Tags) > 0 {
+		b.SetAttributeValue("tags", stringList(f.Tags))
+	}
+	if len(f.Prerequisites) > 0 {
+		b.SetAttributeRaw("prerequisites", g.refs(typeFeature, f.Prerequisites, "id"))
+	}
+
+	if len(f.Environments) == 0 {
+		return
+	}
+	names := make([]string, 0, len(f.Environments))
+	for name := range f.Environments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	envs := make([]hclwrite.ObjectAttrTokens, 0, len(names))
+	for _, name := range names {
+		envs = append(envs, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForValue(cty.StringVal(name)),
+			Value: g.featureEnvironment(f.Environments[name]),
+		})
+	}
+	b.SetAttributeRaw("environments", hclwrite.TokensForObject(envs))
+}
+
+func (g *generator) featureEnvironment(env growthbookapi.FeatureEnvironmentConfig) hclwrite.Tokens {
+	attrs := []hclwrite.ObjectAttrTokens{
+		attrTokens("enabled", hclwrite.TokensForValue(cty.BoolVal(env.Enabled))),
+	}
+	if env.DefaultValue != "" {
+		attrs = append(attrs, attrTokens("default_value", hclwrite.TokensForValue(cty.StringVal(env.DefaultValue))))
+	}
+	rules := make([]hclwrite.Tokens, 0, len(env.Rules))
+	for _, rule := range env.Rules {
+		rules = append(rules, g.featureRule(rule))
+	}
+	attrs = append(attrs, attrTokens("rules", hclwrite.TokensForTuple(rules)))
+	return hclwrite.TokensForObject(attrs)
+}
+
+// featureRule generates a rule, including its ID so that imported rules are matched with the configuration.
+func (g *generator) featureRule(rule growthbookapi.FeatureRule) hclwrite.Tokens {
+	str := func(v string) hclwrite.Tokens { return hclwrite.TokensForValue(cty.StringVal(v)) }
+
+	attrs := []hclwrite.ObjectAttrTokens{
+		attrTokens("id", str(rule.ID)),
+		attrTokens("type", str(rule.Type)),
+		attrTokens("enabled", hclwrite.TokensForValue(cty.BoolVal(rule.Enabled))),
+	}
+	if rule.Description != "" {
+		attrs = append(attrs, attrTokens("description", str(rule.Description)))
+	}
+	if rule.Condition != "" {
+		attrs = append(attrs, attrTokens("condition", str(rule.Condition)))
+	}
+	if rule.Type != "experiment-ref" {
+		attrs = append(attrs, attrTokens("value", str(rule.Value)))
+	}
+	if rule.Coverage != nil {
+		attrs = append(attrs, attrTokens("coverage", hclwrite.TokensForValue(cty.NumberFloatVal(*rule.Coverage))))
+	}
+	if rule.HashAttribute != "" {
+		attrs = append(attrs, attrTokens("hash_attribute", str(rule.HashAttribute)))
+	}
+	if rule.ExperimentID != "" {
+		attrs = append(attrs, attrTokens("experiment_id", str(rule.ExperimentID)))
+	}
+	if len(rule.Variations) > 0 {
+		variations := make([]hclwrite.Tokens, 0, len(rule.Variations))
+		for _, v := range rule.Variations {
+			variations = append(variations, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+				attrTokens("value", str(v.Value)),
+				attrTokens("variation_id", str(v.VariationID)),
+			}))
+		}
+		attrs = append(attrs, attrTokens("variations", hclwrite.TokensForTuple(variations)))
+	}
+	if len(rule.SavedGroupTargeting) > 0 {
+		targeting := make([]hclwrite.Tokens, 0, len(rule.SavedGroupTargeting))
+		for _, t := range rule.SavedGroupTargeting {
+			targeting = append(targeting, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+				attrTokens("match_type", str(t.MatchType)),
+				attrTokens("saved_groups", hclwrite.TokensForValue(stringList(t.SavedGroups))),
+			}))
+		}
+		attrs = append(attrs, attrTokens("saved_group_targeting", hclwrite.TokensForTuple(targeting)))
+	}
+	if len(rule.Prerequisites) > 0 {
+		prereqs := make([]hclwrite.Tokens, 0, len(rule.Prerequisites))
+		for _, p := range rule.Prerequisites {
+			prereqs = append(prereqs, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+				attrTokens("id", g.ref(typeFeature, p.ID, "id")),
+				attrTokens("condition", str(p.Condition)),
+			}))
+		}
+		attrs = append(attrs, attrTokens("prerequisites", hclwrite.TokensForTuple(prereqs)))
+	}
+	return hclwrite.TokensForObject(attrs)
+}
+
+func attrTokens(name string, value hclwrite.Tokens) hclwrite.ObjectAttrTokens {
+	return hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: value}
+}
+
+// write stores the generated files in dir, named after the resource types, e.g. features.tf.
+func (g *generator) write(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	files := map[string]*hclwrite.File{"imports.tf": g.imports}
+	for resourceType, f := range g.files {
+		files[strings.TrimPrefix(resourceType, "growthbook_")+"s.tf"] = f
+	}
+	for name, f := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), hclwrite.Format(f.Bytes()), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package export_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+
+	"terraform-provider-growthbook/internal/export"
+	"terraform-provider-growthbook/internal/growthbookapi"
+	"terraform-provider-growthbook/internal/growthbookapi/apitest"
+)
+
+func TestRun(t *testing.T) {
+	srv := apitest.NewServer()
+	defer srv.Close()
+
+	srv.Seed("projects",
+		map[string]any{"id": "prj_1", "name": "Web App"},
+		map[string]any{"id": "prj_2", "name": "web-app"},
+	)
+	srv.Seed("environments", map[string]any{"id": "production", "projects": []any{"prj_1"}})
+	srv.Seed("attributes", map[string]any{"property": "country", "datatype": "string", "projects": []any{"prj_9"}})
+	srv.Seed("sdk-connections", map[string]any{
+		"id": "sdk_1", "name": "Web", "language": "javascript", "environment": "production",
+		"projects": []any{"prj_1"},
+	})
+	srv.Seed("features",
+		map[string]any{"id": "checkout", "owner": "me", "valueType": "boolean", "defaultValue": "false",
+			"project": "prj_1", "prerequisites": []any{"new-ui"},
+			"environments": map[string]any{
+				"production": map[string]any{"enabled": true, "rules": []any{
+					map[string]any{"id": "fr_1", "type": "force", "enabled": true, "value": "true",
+						"condition": `{"country":"FR"}`},
+				}},
+			},
+		},
+		map[string]any{"id": "new-ui", "owner": "me", "valueType": "boolean", "defaultValue": "true"},
+	)
+
+	dir := t.TempDir()
+	client := growthbookapi.NewClient(srv.APIURL(), srv.APIKey, growthbookapi.WithPageLimit(1))
+	if err := export.Run(context.Background(), client, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	parser := hclparse.NewParser()
+	read := func(name string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, diags := parser.ParseHCL(content, path); diags.HasErrors() {
+			t.Fatalf("%s: %s", name, hcl.Diagnostics(diags).Error())
+		}
+		return string(content)
+	}
+
+	for name, want := range map[string][]string{
+		"projects.tf": {
+			`resource "growthbook_project" "web_app" {`,
+			`resource "growthbook_project" "web-app" {`,
+		},
+		"environments.tf": {
+			`projects       = [growthbook_project.web_app.id]`,
+		},
+		"attributes.tf": {
+			// unknown projects are kept as IDs
+			`projects = ["prj_9"]`,
+		},
+		"sdk_connections.tf": {
+			`environment = growthbook_environment.production.id`,
+		},
+		"features.tf": {
+			`project       = growthbook_project.web_app.id`,
+			`prerequisites = [growthbook_feature.new-ui.id]`,
+			`condition = "{\"country\":\"FR\"}"`,
+			`id        = "fr_1"`,
+		},
+		"imports.tf": {
+			"to = growthbook_project.web_app\n  id = \"prj_1\"",
+			"to = growthbook_attribute.country\n  id = \"country\"",
+			"to = growthbook_feature.checkout\n  id = \"checkout\"",
+		},
+	} {
+		content := read(name)
+		for _, w := range want {
+			if !strings.Contains(content, w) {
+				t.Errorf("%s does not contain %q:\n%s", name, w, content)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+// rejectDraftMode fails the plans of resources writing straight to the live feature when their organization publishes
+// feature changes as drafts, since those writes would bypass its reviews. Destroy plans are only checked when
+// checkDestroy is set, for resources whose deletion writes to the feature.
+func rejectDraftMode(
+	ctx context.Context,
+	clients *providerClients,
+	req resource.ModifyPlanRequest,
+	typeName string,
+	checkDestroy bool,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if clients == nil {
+		return diags
+	}
+
+	var organization types.String
+	if req.Plan.Raw.IsNull() {
+		if !checkDestroy {
+			return diags
+		}
+		diags.Append(req.State.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	} else {
+		diags.Append(req.Plan.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	}
+	client, d := clients.client(organization)
+	if diags.HasError() || d.HasError() {
+		// an unknown organization is reported when applying
+		return diags
+	}
+
+	if client.PublishMode == growthbookapi.PublishModeDraft {
+		diags.AddError("Unsupported publish mode",
+			typeName+" writes directly to the live feature and cannot be used when the provider publish_mode "+
+				"is 'draft', as its changes would bypass reviews. Manage the environments and rules with the "+
+				"environments attribute of growthbook_feature instead, which stages them as a draft revision.")
+	}
+	return diags
+}
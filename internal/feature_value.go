@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Feature values (default values and rule values) are strings holding a boolean, number, string or JSON document
+// depending on the feature value_type. Values read back from GrowthBook keep the text of the prior plan or state when
+// they serve the same value, so that API reformatting of numbers and JSON documents does not produce diffs. This is
+// done by the resources rather than with semantic equality of a custom type, which cannot see the value type: `1.0`
+// and `1` are the same value for a number feature but not for a string feature.
+
+// keepFeatureValue returns prior when it is known and serves the same value as current for a feature of the given
+// value type, and current otherwise.
+func keepFeatureValue(valueType string, prior, current types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() || current.IsNull() || current.IsUnknown() {
+		return current
+	}
+	if equivalentFeatureValues(valueType, prior.ValueString(), current.ValueString()) {
+		return prior
+	}
+	return current
+}
+
+// keepFeatureRuleValues applies keepFeatureValue to the values of rules, matched by position.
+func keepFeatureRuleValues(valueType string, prior, current []featureRuleModel) {
+	for i := range current {
+		if i < len(prior) {
+			current[i].Value = keepFeatureValue(valueType, prior[i].Value, current[i].Value)
+		}
+	}
+}
+
+// equivalentFeatureValues reports whether two values serve the same value for a feature of the given value type.
+// Number features compare numbers exactly, e.g. `1.0` and `1`, and json features compare documents, e.g.
+// `{"a": 1}` and `{"a":1}`. Other values, including all values of string features, compare byte for byte.
+func equivalentFeatureValues(valueType, a, b string) bool {
+	if a == b {
+		return true
+	}
+	if valueType != "number" && valueType != "json" {
+		return false
+	}
+	x, ok := decodeFeatureValue(a)
+	if !ok {
+		return false
+	}
+	y, ok := decodeFeatureValue(b)
+	if !ok {
+		return false
+	}
+	if valueType == "number" {
+		if _, isNumber := x.(json.Number); !isNumber {
+			return false
+		}
+	}
+	return jsonValuesEqual(x, y)
+}
+
+// decodeFeatureValue decodes a single JSON value, keeping numbers as json.Number so that no precision is lost.
+func decodeFeatureValue(s string) (any, bool) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+	return v, true
+}
+
+// jsonValuesEqual compares values decoded by decodeFeatureValue, numbers by their exact decimal value.
+func jsonValuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, okX := new(big.Rat).SetString(a.String())
+		y, okY := new(big.Rat).SetString(b.String())
+		return okX && okY && x.Cmp(y) == 0
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			w, ok := b[k]
+			if !ok || !jsonValuesEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		// strings, booleans and null
+		return a == b
+	}
+}
+
+// validateFeatureValue checks that a value can be served by a feature of the given value type.
+func validateFeatureValue(valueType, value string) error {
+	switch valueType {
+	case "boolean":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("expected 'true' or 'false' for a boolean feature, got %q", value)
+		}
+	case "number":
+		// JSON numbers only: GrowthBook cannot serve values such as NaN, Inf, 0x1p3 or 1_000
+		v, ok := decodeFeatureValue(value)
+		if _, isNumber := v.(json.Number); !ok || !isNumber {
+			return fmt.Errorf("expected a number for a number feature, got %q", value)
+		}
+	case "json":
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("expected valid JSON for a json feature, got %q", value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package internal
+
+import "testing"
+
+func TestEquivalentFeatureValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		valueType string
+		a, b      string
+		want      bool
+	}{
+		{"string", "1.0", "1.0", true},
+		{"string", "1.0", "1", false},
+		{"string", `{"a":1}`, `{"a": 1}`, false},
+		{"boolean", "true", "true", true},
+		{"boolean", "true", " true", false},
+		{"number", "1.0", "1", true},
+		{"number", "1e3", "1000", true},
+		{"number", "12345678901234567890", "12345678901234567891", false},
+		{"number", "0.1", "0.10000000000000001", false},
+		{"number", `"1"`, "1", false},
+		{"json", `{"a": 1, "b": [true, null]}`, `{"b":[true,null],"a":1.0}`, true},
+		{"json", `{"a":1}`, `{"a":1,"b":2}`, false},
+		{"json", `[1,2]`, `[2,1]`, false},
+		{"json", `{"id":9007199254740993}`, `{"id":9007199254740992}`, false},
+		{"json", `{"a":1} {}`, `{"a":1}`, false},
+		{"json", `"a"`, `"a" `, true},
+	}
+	for _, tt := range tests {
+		if got := equivalentFeatureValues(tt.valueType, tt.a, tt.b); got != tt.want {
+			t.Errorf("equivalentFeatureValues(%q, %q, %q) = %v, want %v", tt.valueType, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestValidateFeatureValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		valueType string
+		value     string
+		valid     bool
+	}{
+		{"boolean", "true", true},
+		{"boolean", "True", false},
+		{"number", "42", true},
+		{"number", "-1.5e3", true},
+		{"number", " 7 ", true},
+		{"number", "NaN", false},
+		{"number", "Inf", false},
+		{"number", "-Infinity", false},
+		{"number", "0x1p3", false},
+		{"number", "1_000", false},
+		{"number", "+1", false},
+		{"number", "01", false},
+		{"number", ".5", false},
+		{"number", `"1"`, false},
+		{"number", "1 2", false},
+		{"number", "", false},
+		{"string", "NaN", true},
+		{"json", `{"a": [1, 2]}`, true},
+		{"json", `{"a":`, false},
+	}
+	for _, tt := range tests {
+		err := validateFeatureValue(tt.valueType, tt.value)
+		if got := err == nil; got != tt.valid {
+			t.Errorf("validateFeatureValue(%q, %q) = %v, want valid %v", tt.valueType, tt.value, err, tt.valid)
+		}
+	}
+}
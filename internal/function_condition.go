@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &conditionFunction{}
+
+func newConditionFunction() function.Function {
+	return &conditionFunction{}
+}
+
+// conditionFunction builds a GrowthBook targeting condition from an HCL object.
+type conditionFunction struct{}
+
+func (f *conditionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "condition"
+}
+
+func (f *conditionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a GrowthBook targeting condition",
+		Description: "Encodes an object as a GrowthBook (Mongo-style) targeting condition, " +
+			"returning canonical JSON with sorted keys. Unknown '$' operators are rejected.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "condition",
+				Description: "Condition object, e.g. { country = { \"$in\" = [\"US\", \"CA\"] } }.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *conditionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := conditionValueToGo(arg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	condition, err := canonicalCondition(v)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid condition: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringValue(condition)))
+}
+
+// conditionValueToGo converts a Terraform value to the Go value encoding/json expects.
+func conditionValueToGo(v attr.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil //nolint:nilnil
+	}
+	if v.IsUnknown() {
+		return nil, errors.New("condition contains an unknown value")
+	}
+
+	switch val := v.(type) {
+	case types.Dynamic:
+		return conditionValueToGo(val.UnderlyingValue())
+	case types.String:
+		return val.ValueString(), nil
+	case types.Bool:
+		return val.ValueBool(), nil
+	case types.Number:
+		return json.Number(val.ValueBigFloat().Text('g', -1)), nil
+	case types.Object:
+		return conditionAttrsToGo(val.Attributes())
+	case types.Map:
+		return conditionAttrsToGo(val.Elements())
+	case types.Tuple:
+		return conditionElemsToGo(val.Elements())
+	case types.List:
+		return conditionElemsToGo(val.Elements())
+	case types.Set:
+		return conditionElemsToGo(val.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value of type %s in condition", v.Type(context.Background()))
+	}
+}
+
+func conditionAttrsToGo(attrs map[string]attr.Value) (map[string]any, error) {
+	out := make(map[string]any, len(attrs))
+	for k, e := range attrs {
+		v, err := conditionValueToGo(e)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+func conditionElemsToGo(elems []attr.Value) ([]any, error) {
+	out := make([]any, len(elems))
+	for i, e := range elems {
+		v, err := conditionValueToGo(e)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package internal_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccConditionFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::growthbook::condition({
+    "$or" = [
+      { country = { "$in" = ["US", "CA"] } },
+      { beta = true, version = { "$vgte" = "1.2.0" } },
+    ]
+    id = { "$exists" = true }
+  })
+}
+`,
+				Check: resource.TestCheckOutput("test",
+					`{"$or":[{"country":{"$in":["US","CA"]}},{"beta":true,"version":{"$vgte":"1.2.0"}}],`+
+						`"id":{"$exists":true}}`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::growthbook::condition({ country = { "$within" = ["US"] } })
+}
+`,
+				ExpectError: regexp.MustCompile(`unknown operator "\$within"`),
+			},
+		},
+	})
+}
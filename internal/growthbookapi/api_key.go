@@ -0,0 +1,33 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// CreateAPIKey creates a new API key in GrowthBook. The returned key holds the only copy of its Key.
+func (c *Client) CreateAPIKey(ctx context.Context, k *APIKey) (*APIKey, error) {
+	out, err := fetcher[APIKey](c, "POST", "/api-keys").One(ctx, k, "apiKey")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAPIKey fetches an API key by its ID, without its Key.
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	out, err := fetcher[APIKey](c, "GET", "/api-keys/"+id).One(ctx, nil, "apiKey")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAPIKey revokes an API key by its ID.
+func (c *Client) DeleteAPIKey(ctx context.Context, id string) error {
+	return c.delete(ctx, "/api-keys/"+id)
+}
+
+// ListAPIKeys fetches all API keys, without their Key, handling pagination.
+func (c *Client) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	return fetcher[APIKey](c, "GET", "/api-keys").All(ctx, nil, "apiKeys")
+}
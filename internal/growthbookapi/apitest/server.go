@@ -0,0 +1,597 @@
+// Package apitest provides an in-process fake of the GrowthBook v1 REST API for tests.
+//
+// The fake keeps objects in memory as plain JSON maps, paginates list endpoints with
+// hasMore/nextOffset when a limit is given, and can inject failures (404, 429, 5xx)
+// to exercise the retry paths of the client.
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fault makes the next Times requests matching Method and Path fail with Status.
+// Path matches the request path (without the /api/v1 prefix and query) by prefix, an empty Method matches any method.
+type Fault struct {
+	Method string
+	Path   string
+	Status int
+	Times  int
+}
+
+// collection describes how a GrowthBook object type is exposed by the API.
+type collection struct {
+	// singular and plural are the response keys for one object and for lists.
+	singular string
+	plural   string
+	// idField is the key holding the object identifier.
+	idField string
+	// idPrefix is used to generate identifiers when the client does not provide one.
+	idPrefix string
+	// onWrite fills computed fields after creation and updates.
+	onWrite func(s *Server, item map[string]any)
+	// createOnly is a field only returned in the response to the creation, like the key of API keys.
+	createOnly string
+
+	items []map[string]any
+}
+
+// Server is a fake GrowthBook API backed by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the bearer token expected on every request.
+	APIKey string
+
+	mu          sync.Mutex
+	seq         int
+	collections map[string]*collection
+	revisions   map[string][]map[string]any
+	settings    map[string]any
+	faults      []*Fault
+	requests    []string
+}
+
+// NewServer starts a fake GrowthBook API. Callers must Close it.
+func NewServer() *Server {
+	s := &Server{
+		APIKey:    "secret_apitest",
+		revisions: map[string][]map[string]any{},
+		settings: map[string]any{
+			"statsEngine":           "bayesian",
+			"confidenceLevel":       0.95,
+			"requireReviews":        []any{},
+			"useStickyBucketing":    false,
+			"useFallbackAttributes": false,
+			"featureKeyExample":     "",
+			"featureRegexValidator": "",
+		},
+		collections: map[string]*collection{
+			"projects": {
+				singular: "project", plural: "projects", idField: "id", idPrefix: "prj_",
+			},
+			"environments": {
+				singular: "environment", plural: "environments", idField: "id", idPrefix: "env_",
+			},
+			"attributes": {
+				singular: "attribute", plural: "attributes", idField: "property", idPrefix: "attr_",
+			},
+			"saved-groups": {
+				singular: "savedGroup", plural: "savedGroups", idField: "id", idPrefix: "grp_",
+			},
+			"features": {
+				singular: "feature", plural: "features", idField: "id", idPrefix: "feat_",
+				onWrite: featureDefaults,
+			},
+			"sdk-connections": {
+				singular: "sdkConnection", plural: "connections", idField: "id", idPrefix: "sdk_",
+				onWrite: sdkConnectionDefaults,
+			},
+			"api-keys": {
+				singular: "apiKey", plural: "apiKeys", idField: "id", idPrefix: "key_",
+				onWrite: apiKeyDefaults, createOnly: "key",
+			},
+			"members": {
+				singular: "member", plural: "members", idField: "id", idPrefix: "u_",
+			},
+			"teams": {
+				singular: "team", plural: "teams", idField: "id", idPrefix: "team_",
+			},
+			"roles": {
+				singular: "role", plural: "roles", idField: "id", idPrefix: "role_",
+			},
+			"sdk-webhooks": {
+				singular: "webhook", plural: "webhooks", idField: "id", idPrefix: "wh_",
+				onWrite: signingKeyDefault("wk_"),
+			},
+			"event-webhooks": {
+				singular: "eventWebhook", plural: "eventWebhooks", idField: "id", idPrefix: "ewh_",
+				onWrite: signingKeyDefault("ewhk_"),
+			},
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// APIURL returns the base URL to configure clients and the provider with.
+func (s *Server) APIURL() string {
+	return s.URL + "/api/v1"
+}
+
+// Inject registers a failure for upcoming requests.
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// Requests returns the requests received so far, formatted as "METHOD /path?query".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Seed stores objects directly, bypassing the API, e.g. to build large lists for pagination.
+// collectionPath is the API path segment, e.g. "projects".
+func (s *Server) Seed(collectionPath string, items ...map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := s.collections[collectionPath]
+	for _, item := range items {
+		s.insert(c, item)
+	}
+}
+
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s%06d", prefix, s.seq)
+}
+
+func (s *Server) insert(c *collection, item map[string]any) map[string]any {
+	if id, _ := item[c.idField].(string); id == "" {
+		item[c.idField] = s.nextID(c.idPrefix)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if _, ok := item["dateCreated"]; !ok {
+		item["dateCreated"] = now
+	}
+	item["dateUpdated"] = now
+	if c.onWrite != nil {
+		c.onWrite(s, item)
+	}
+	c.items = append(c.items, item)
+	return item
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+
+	if r.Header.Get("Authorization") != "Bearer "+s.APIKey {
+		writeError(w, http.StatusUnauthorized, "invalid API key")
+		return
+	}
+
+	p := strings.TrimPrefix(r.URL.Path, "/api/v1")
+	if s.fault(w, r.Method, p) {
+		return
+	}
+
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+	if len(parts) > 2 && parts[0] == "features" && parts[2] == "revisions" {
+		s.featureRevisions(w, r, parts[1], parts[3:])
+		return
+	}
+	if len(parts) == 3 && parts[0] == "features" && parts[2] == "toggle" && r.Method == http.MethodPost {
+		s.toggleFeature(w, r, parts[1])
+		return
+	}
+	if len(parts) == 3 && parts[0] == "members" && parts[2] == "role" && r.Method == http.MethodPost {
+		s.updateMemberRole(w, r, parts[1])
+		return
+	}
+	if len(parts) == 3 && parts[0] == "sdk-connections" && parts[2] == "webhooks" {
+		s.sdkWebhooks(w, r, parts[1])
+		return
+	}
+	if len(parts) == 1 && parts[0] == "settings" {
+		s.organizationSettings(w, r)
+		return
+	}
+	c, ok := s.collections[parts[0]]
+	if !ok || len(parts) > 2 {
+		writeError(w, http.StatusNotFound, "unknown route "+p)
+		return
+	}
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, r, c)
+		case http.MethodPost:
+			s.create(w, r, c)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	i := c.index(parts[1])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "could not find "+c.singular+" "+parts[1])
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{c.singular: c.items[i]})
+	case http.MethodPost, http.MethodPut:
+		s.update(w, r, c, i)
+	case http.MethodDelete:
+		c.items = append(c.items[:i], c.items[i+1:]...)
+		writeJSON(w, http.StatusOK, map[string]any{"deletedId": parts[1]})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+// fault writes an injected failure when one matches the request.
+func (s *Server) fault(w http.ResponseWriter, method, p string) bool {
+	for i, f := range s.faults {
+		if (f.Method != "" && f.Method != method) || !strings.HasPrefix(p, f.Path) {
+			continue
+		}
+		f.Times--
+		if f.Times <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		if f.Status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		writeError(w, f.Status, "injected failure")
+		return true
+	}
+	return false
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c *collection) {
+	items := c.items
+	if projectID := r.URL.Query().Get("projectId"); projectID != "" {
+		items = filterByProject(items, projectID)
+	}
+	if items == nil {
+		items = []map[string]any{}
+	}
+
+	// unpaginated calls get everything, like the environments and attributes endpoints
+	limitParam := r.URL.Query().Get("limit")
+	if limitParam == "" {
+		writeJSON(w, http.StatusOK, map[string]any{c.plural: items})
+		return
+	}
+
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid limit")
+		return
+	}
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	offset = min(max(offset, 0), len(items))
+	end := min(offset+limit, len(items))
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		c.plural:     items[offset:end],
+		"limit":      limit,
+		"offset":     offset,
+		"count":      end - offset,
+		"total":      len(items),
+		"hasMore":    end < len(items),
+		"nextOffset": end,
+	})
+}
+
+// filterByProject keeps the items of a project, whether they have a single "project" or a "projects" list.
+func filterByProject(items []map[string]any, projectID string) []map[string]any {
+	var out []map[string]any
+	for _, item := range items {
+		if p, _ := item["project"].(string); p == projectID {
+			out = append(out, item)
+			continue
+		}
+		projects, _ := item["projects"].([]any)
+		for _, p := range projects {
+			if p == projectID {
+				out = append(out, item)
+				break
+			}
+		}
+	}
+	return out
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, c *collection) {
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+	if id, _ := body[c.idField].(string); id != "" && c.index(id) >= 0 {
+		writeError(w, http.StatusBadRequest, c.singular+" "+id+" already exists")
+		return
+	}
+	item := s.insert(c, body)
+	if c.createOnly == "" {
+		writeJSON(w, http.StatusOK, map[string]any{c.singular: item})
+		return
+	}
+	out := maps.Clone(item)
+	delete(item, c.createOnly)
+	writeJSON(w, http.StatusOK, map[string]any{c.singular: out})
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, c *collection, i int) {
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+	item := c.items[i]
+	for k, v := range body {
+		if k == c.idField {
+			continue
+		}
+		item[k] = v
+	}
+	item["dateUpdated"] = time.Now().UTC().Format(time.RFC3339)
+	if c.onWrite != nil {
+		c.onWrite(s, item)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{c.singular: item})
+}
+
+func (c *collection) index(id string) int {
+	for i, item := range c.items {
+		if item[c.idField] == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateMemberRole serves /members/{id}/role, members are otherwise only listed and seeded.
+func (s *Server) updateMemberRole(w http.ResponseWriter, r *http.Request, memberID string) {
+	members := s.collections["members"]
+	i := members.index(memberID)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "could not find member "+memberID)
+		return
+	}
+	s.update(w, r, members, i)
+}
+
+// sdkWebhooks serves /sdk-connections/{id}/webhooks. The webhooks are stored in the sdk-webhooks collection, with
+// the connection in their "sdks".
+func (s *Server) sdkWebhooks(w http.ResponseWriter, r *http.Request, sdkConnectionID string) {
+	if s.collections["sdk-connections"].index(sdkConnectionID) < 0 {
+		writeError(w, http.StatusNotFound, "could not find sdkConnection "+sdkConnectionID)
+		return
+	}
+	webhooks := s.collections["sdk-webhooks"]
+	switch r.Method {
+	case http.MethodGet:
+		items := []map[string]any{}
+		for _, item := range webhooks.items {
+			if sdks, _ := item["sdks"].([]any); slices.Contains(sdks, any(sdkConnectionID)) {
+				items = append(items, item)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{webhooks.plural: items})
+	case http.MethodPost:
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return
+		}
+		body["sdks"] = []any{sdkConnectionID}
+		writeJSON(w, http.StatusOK, map[string]any{webhooks.singular: s.insert(webhooks, body)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+// organizationSettings serves /settings. Updates only change the settings in the body.
+func (s *Server) organizationSettings(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return
+		}
+		maps.Copy(s.settings, body)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"settings": s.settings})
+}
+
+// toggleFeature serves /features/{id}/toggle, which only changes the enabled flag of environments.
+func (s *Server) toggleFeature(w http.ResponseWriter, r *http.Request, featureID string) {
+	features := s.collections["features"]
+	i := features.index(featureID)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "could not find feature "+featureID)
+		return
+	}
+	var body struct {
+		Environments map[string]bool `json:"environments"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+	feature := features.items[i]
+	envs, _ := feature["environments"].(map[string]any)
+	if envs == nil {
+		envs = map[string]any{}
+		feature["environments"] = envs
+	}
+	for env, enabled := range body.Environments {
+		cfg, _ := envs[env].(map[string]any)
+		if cfg == nil {
+			cfg = map[string]any{"rules": []any{}}
+			envs[env] = cfg
+		}
+		cfg["enabled"] = enabled
+	}
+	feature["dateUpdated"] = time.Now().UTC().Format(time.RFC3339)
+	writeJSON(w, http.StatusOK, map[string]any{"feature": feature})
+}
+
+// featureRevisions serves /features/{id}/revisions, /revisions/{version}/publish and /revisions/{version}/discard.
+// Publishing applies the default value and environments of the revision to the feature.
+func (s *Server) featureRevisions(w http.ResponseWriter, r *http.Request, featureID string, rest []string) {
+	features := s.collections["features"]
+	i := features.index(featureID)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "could not find feature "+featureID)
+		return
+	}
+	feature := features.items[i]
+
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			revisions := s.revisions[featureID]
+			if revisions == nil {
+				revisions = []map[string]any{}
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"revisions": revisions, "hasMore": false})
+		case http.MethodPost:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+				return
+			}
+			body["version"] = len(s.revisions[featureID]) + 2
+			body["baseVersion"] = len(s.revisions[featureID]) + 1
+			body["status"] = "draft"
+			body["dateCreated"] = time.Now().UTC().Format(time.RFC3339)
+			featureDefaults(s, body)
+			s.revisions[featureID] = append(s.revisions[featureID], body)
+			writeJSON(w, http.StatusOK, map[string]any{"revision": body})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	var revision map[string]any
+	for _, rev := range s.revisions[featureID] {
+		if fmt.Sprint(rev["version"]) == rest[0] {
+			revision = rev
+		}
+	}
+	if revision == nil || len(rest) != 2 || r.Method != http.MethodPost {
+		writeError(w, http.StatusNotFound, "could not find revision "+strings.Join(rest, "/"))
+		return
+	}
+	if revision["status"] == "published" || revision["status"] == "discarded" {
+		writeError(w, http.StatusBadRequest, fmt.Sprint("revision is ", revision["status"]))
+		return
+	}
+	switch rest[1] {
+	case "publish":
+		if v, ok := revision["defaultValue"]; ok {
+			feature["defaultValue"] = v
+		}
+		envs, _ := feature["environments"].(map[string]any)
+		if envs == nil {
+			envs = map[string]any{}
+			feature["environments"] = envs
+		}
+		drafts, _ := revision["environments"].(map[string]any)
+		for env, draft := range drafts {
+			envs[env] = draft
+		}
+		feature["dateUpdated"] = time.Now().UTC().Format(time.RFC3339)
+		revision["status"] = "published"
+		revision["datePublished"] = feature["dateUpdated"]
+	case "discard":
+		revision["status"] = "discarded"
+	default:
+		writeError(w, http.StatusNotFound, "unknown revision action "+rest[1])
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"revision": revision})
+}
+
+// featureDefaults assigns IDs to new rules, as GrowthBook does.
+func featureDefaults(s *Server, item map[string]any) {
+	envs, _ := item["environments"].(map[string]any)
+	for _, env := range envs {
+		rules, _ := env.(map[string]any)["rules"].([]any)
+		for _, rule := range rules {
+			rule, _ := rule.(map[string]any)
+			if id, _ := rule["id"].(string); rule != nil && id == "" {
+				rule["id"] = s.nextID("fr_")
+			}
+		}
+	}
+}
+
+// sdkConnectionDefaults fills the client key and languages list of SDK connections.
+func sdkConnectionDefaults(s *Server, item map[string]any) {
+	if key, _ := item["key"].(string); key == "" {
+		item["key"] = s.nextID("sdk-")
+	}
+	if lang, _ := item["language"].(string); lang != "" {
+		item["languages"] = []any{lang}
+	}
+}
+
+func apiKeyDefaults(s *Server, item map[string]any) {
+	if secret, _ := item["secret"].(bool); !secret {
+		delete(item, "role")
+		item["key"] = s.nextID("secret_user_")
+		return
+	}
+	role, _ := item["role"].(string)
+	if role == "" {
+		role = "admin"
+		item["role"] = role
+	}
+	item["key"] = s.nextID("secret_" + role + "_")
+}
+
+// signingKeyDefault generates the signing key of webhooks.
+func signingKeyDefault(prefix string) func(s *Server, item map[string]any) {
+	return func(s *Server, item map[string]any) {
+		if key, _ := item["signingKey"].(string); key == "" {
+			item["signingKey"] = s.nextID(prefix)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]any{"message": msg})
+}
@@ -22,8 +22,10 @@ func (c *Client) CreateAttribute(ctx context.Context, a *Attribute) (*Attribute,
 	return &out, nil
 }
 
+// GetAttribute fetches an attribute by its property. GrowthBook has no endpoint to get a single attribute,
+// so the cached attribute list is scanned.
 func (c *Client) GetAttribute(ctx context.Context, property string) (*Attribute, error) {
-	out, err := fetcher[[]Attribute](c, "GET", "/attributes").One(ctx, nil, "attributes")
+	out, err := c.ListAttributes(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +37,13 @@ func (c *Client) GetAttribute(ctx context.Context, property string) (*Attribute,
 	return nil, ErrNotFound
 }
 
+// ListAttributes fetches all attributes. The list is cached until an attribute is written.
+func (c *Client) ListAttributes(ctx context.Context) ([]Attribute, error) {
+	return cached(c, "/attributes", func() ([]Attribute, error) {
+		return fetcher[[]Attribute](c, "GET", "/attributes").One(ctx, nil, "attributes")
+	})
+}
+
 func (c *Client) UpdateAttribute(ctx context.Context, property string, a *Attribute) (*Attribute, error) {
 	body := &AttributeUpdateBody{
 		DataType: 		a.DataType,
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -17,6 +18,8 @@ var (
 type ClientAPI interface {
 	// FindProjectByName retrieves a project by its name.
 	FindProjectByName(ctx context.Context, name string) (*Project, error)
+	// ListProjects retrieves all projects.
+	ListProjects(ctx context.Context) ([]Project, error)
 	// CreateProject creates a new project.
 	CreateProject(ctx context.Context, p *Project) (*Project, error)
 	// GetProject retrieves a project by its ID.
@@ -45,6 +48,28 @@ type ClientAPI interface {
 	DeleteFeature(ctx context.Context, id string) error
 	// FindFeatureByName retrieves a feature by its ID.
 	FindFeatureByName(ctx context.Context, id string) (*Feature, error)
+	// ListFeatures retrieves all features, or the features of a project.
+	ListFeatures(ctx context.Context, filter ListFilter) ([]Feature, error)
+	// UpdateFeatureEnvironment replaces the configuration of a single environment of a feature.
+	UpdateFeatureEnvironment(ctx context.Context, featureID, env string, cfg FeatureEnvironmentConfig) (*Feature, error)
+	// ToggleFeature enables or disables a feature in some environments.
+	ToggleFeature(ctx context.Context, id string, envs map[string]bool, reason string) (*Feature, error)
+	// CreateFeatureRevision creates a draft revision of a feature.
+	CreateFeatureRevision(ctx context.Context, featureID string, r *FeatureRevision) (*FeatureRevision, error)
+	// ListFeatureRevisions retrieves all revisions of a feature.
+	ListFeatureRevisions(ctx context.Context, featureID string) ([]FeatureRevision, error)
+	// PublishFeatureRevision publishes a draft revision of a feature.
+	PublishFeatureRevision(ctx context.Context, featureID string, version int) (*FeatureRevision, error)
+	// DiscardFeatureRevision discards a draft revision of a feature.
+	DiscardFeatureRevision(ctx context.Context, featureID string, version int) (*FeatureRevision, error)
+	// GetFeatureRule retrieves a single rule of a feature environment by its ID.
+	GetFeatureRule(ctx context.Context, featureID, env, ruleID string) (*FeatureRule, error)
+	// CreateFeatureRule inserts a rule in a feature environment.
+	CreateFeatureRule(ctx context.Context, featureID, env string, rule FeatureRule, at RulePlacement) (*FeatureRule, error)
+	// UpdateFeatureRule replaces a rule of a feature environment, moving it when at is set.
+	UpdateFeatureRule(ctx context.Context, featureID, env string, rule FeatureRule, at *RulePlacement) (*FeatureRule, error)
+	// DeleteFeatureRule removes a rule from a feature environment.
+	DeleteFeatureRule(ctx context.Context, featureID, env, ruleID string) error
 	// CreateSDKConnection creates a new SDK connection.
 	CreateSDKConnection(ctx context.Context, c *SDKConnection) (*SDKConnection, error)
 	// GetSDKConnection retrieves an SDK connection by its ID.
@@ -55,14 +80,142 @@ type ClientAPI interface {
 	DeleteSDKConnection(ctx context.Context, id string) error
 	// FindSDKConnectionByName retrieves an SDK connection by its name.
 	FindSDKConnectionByName(ctx context.Context, name string) (*SDKConnection, error)
+	// ListSDKConnections retrieves all SDK connections, or the SDK connections of a project.
+	ListSDKConnections(ctx context.Context, filter ListFilter) ([]SDKConnection, error)
 	// CreateAttribute creates a new attribute
 	CreateAttribute(ctx context.Context, a *Attribute) (*Attribute, error)
 	// GetAttribute retrieves a features by its Property
 	GetAttribute(ctx context.Context, property string) (*Attribute, error)
+	// ListAttributes retrieves all attributes.
+	ListAttributes(ctx context.Context) ([]Attribute, error)
 	// UpdateAttribute updates an existing attribute by its property
 	UpdateAttribute(ctx context.Context, property string, a *Attribute) (*Attribute, error)
 	// DeleteAttribute deletes an attribute by its property
 	DeleteAttribute(ctx context.Context, property string) error
+	// CreateSavedGroup creates a new saved group.
+	CreateSavedGroup(ctx context.Context, g *SavedGroup) (*SavedGroup, error)
+	// GetSavedGroup retrieves a saved group by its ID.
+	GetSavedGroup(ctx context.Context, id string) (*SavedGroup, error)
+	// UpdateSavedGroup updates an existing saved group by its ID.
+	UpdateSavedGroup(ctx context.Context, id string, body *SavedGroupUpdateBody) (*SavedGroup, error)
+	// DeleteSavedGroup deletes a saved group by its ID.
+	DeleteSavedGroup(ctx context.Context, id string) error
+	// ListSavedGroups retrieves all saved groups.
+	ListSavedGroups(ctx context.Context) ([]SavedGroup, error)
+	// CreateExperiment creates a new experiment.
+	CreateExperiment(ctx context.Context, e *ExperimentBody) (*Experiment, error)
+	// GetExperiment retrieves an experiment by its ID.
+	GetExperiment(ctx context.Context, id string) (*Experiment, error)
+	// UpdateExperiment updates an existing experiment by its ID.
+	UpdateExperiment(ctx context.Context, id string, e *ExperimentBody) (*Experiment, error)
+	// ArchiveExperiment archives an experiment by its ID.
+	ArchiveExperiment(ctx context.Context, id string) error
+	// ListExperiments retrieves all experiments.
+	ListExperiments(ctx context.Context) ([]Experiment, error)
+	// CreateMetric creates a new legacy metric.
+	CreateMetric(ctx context.Context, m *Metric) (*Metric, error)
+	// GetMetric retrieves a legacy metric by its ID.
+	GetMetric(ctx context.Context, id string) (*Metric, error)
+	// UpdateMetric updates an existing legacy metric by its ID.
+	UpdateMetric(ctx context.Context, id string, m *Metric) (*Metric, error)
+	// DeleteMetric deletes a legacy metric by its ID.
+	DeleteMetric(ctx context.Context, id string) error
+	// ListMetrics retrieves all legacy metrics.
+	ListMetrics(ctx context.Context) ([]Metric, error)
+	// CreateFactMetric creates a new fact metric.
+	CreateFactMetric(ctx context.Context, m *FactMetric) (*FactMetric, error)
+	// GetFactMetric retrieves a fact metric by its ID.
+	GetFactMetric(ctx context.Context, id string) (*FactMetric, error)
+	// UpdateFactMetric updates an existing fact metric by its ID.
+	UpdateFactMetric(ctx context.Context, id string, m *FactMetric) (*FactMetric, error)
+	// DeleteFactMetric deletes a fact metric by its ID.
+	DeleteFactMetric(ctx context.Context, id string) error
+	// ListFactMetrics retrieves all fact metrics.
+	ListFactMetrics(ctx context.Context) ([]FactMetric, error)
+	// CreateDataSource creates a new data source.
+	CreateDataSource(ctx context.Context, d *DataSourceBody) (*DataSource, error)
+	// GetDataSource retrieves a data source by its ID.
+	GetDataSource(ctx context.Context, id string) (*DataSource, error)
+	// UpdateDataSource updates an existing data source by its ID.
+	UpdateDataSource(ctx context.Context, id string, d *DataSourceBody) (*DataSource, error)
+	// DeleteDataSource deletes a data source by its ID.
+	DeleteDataSource(ctx context.Context, id string) error
+	// ListDataSources retrieves all data sources.
+	ListDataSources(ctx context.Context) ([]DataSource, error)
+	// CreateFactTable creates a new fact table.
+	CreateFactTable(ctx context.Context, t *FactTable) (*FactTable, error)
+	// GetFactTable retrieves a fact table by its ID.
+	GetFactTable(ctx context.Context, id string) (*FactTable, error)
+	// UpdateFactTable updates an existing fact table by its ID.
+	UpdateFactTable(ctx context.Context, id string, t *FactTable) (*FactTable, error)
+	// DeleteFactTable deletes a fact table by its ID.
+	DeleteFactTable(ctx context.Context, id string) error
+	// ListFactTables retrieves all fact tables.
+	ListFactTables(ctx context.Context) ([]FactTable, error)
+	// CreateFactTableFilter creates a new filter on a fact table.
+	CreateFactTableFilter(ctx context.Context, factTableID string, f *FactTableFilter) (*FactTableFilter, error)
+	// UpdateFactTableFilter updates an existing filter of a fact table.
+	UpdateFactTableFilter(ctx context.Context, factTableID, id string, f *FactTableFilter) (*FactTableFilter, error)
+	// DeleteFactTableFilter deletes a filter of a fact table.
+	DeleteFactTableFilter(ctx context.Context, factTableID, id string) error
+	// ListFactTableFilters retrieves all filters of a fact table.
+	ListFactTableFilters(ctx context.Context, factTableID string) ([]FactTableFilter, error)
+	// CreateAPIKey creates a new API key.
+	CreateAPIKey(ctx context.Context, k *APIKey) (*APIKey, error)
+	// GetAPIKey retrieves an API key by its ID.
+	GetAPIKey(ctx context.Context, id string) (*APIKey, error)
+	// DeleteAPIKey revokes an API key by its ID.
+	DeleteAPIKey(ctx context.Context, id string) error
+	// ListAPIKeys retrieves all API keys.
+	ListAPIKeys(ctx context.Context) ([]APIKey, error)
+	// GetMember retrieves a member of the organization by its user ID.
+	GetMember(ctx context.Context, id string) (*Member, error)
+	// ListMembers retrieves all members of the organization.
+	ListMembers(ctx context.Context) ([]Member, error)
+	// UpdateMemberRole replaces the roles of a member.
+	UpdateMemberRole(ctx context.Context, id string, r *MemberRole) (*Member, error)
+	// CreateTeam creates a new team.
+	CreateTeam(ctx context.Context, t *Team) (*Team, error)
+	// GetTeam retrieves a team by its ID.
+	GetTeam(ctx context.Context, id string) (*Team, error)
+	// UpdateTeam updates an existing team by its ID.
+	UpdateTeam(ctx context.Context, id string, t *Team) (*Team, error)
+	// DeleteTeam deletes a team by its ID.
+	DeleteTeam(ctx context.Context, id string) error
+	// ListTeams retrieves all teams.
+	ListTeams(ctx context.Context) ([]Team, error)
+	// CreateRole creates a new custom role.
+	CreateRole(ctx context.Context, r *Role) (*Role, error)
+	// GetRole retrieves a custom role by its ID.
+	GetRole(ctx context.Context, id string) (*Role, error)
+	// UpdateRole updates an existing custom role by its ID.
+	UpdateRole(ctx context.Context, id string, r *Role) (*Role, error)
+	// DeleteRole deletes a custom role by its ID.
+	DeleteRole(ctx context.Context, id string) error
+	// GetOrganizationSettings retrieves the settings of the organization.
+	GetOrganizationSettings(ctx context.Context) (*OrganizationSettings, error)
+	// UpdateOrganizationSettings changes the settings that are set and keeps the others.
+	UpdateOrganizationSettings(ctx context.Context, s *OrganizationSettings) (*OrganizationSettings, error)
+	// CreateSDKWebhook creates a webhook of an SDK connection.
+	CreateSDKWebhook(ctx context.Context, sdkConnectionID string, w *SDKWebhook) (*SDKWebhook, error)
+	// GetSDKWebhook retrieves an SDK webhook by its ID.
+	GetSDKWebhook(ctx context.Context, id string) (*SDKWebhook, error)
+	// UpdateSDKWebhook updates an existing SDK webhook by its ID.
+	UpdateSDKWebhook(ctx context.Context, id string, w *SDKWebhook) (*SDKWebhook, error)
+	// DeleteSDKWebhook deletes an SDK webhook by its ID.
+	DeleteSDKWebhook(ctx context.Context, id string) error
+	// ListSDKWebhooks retrieves the webhooks of an SDK connection.
+	ListSDKWebhooks(ctx context.Context, sdkConnectionID string) ([]SDKWebhook, error)
+	// CreateEventWebhook creates a new event webhook.
+	CreateEventWebhook(ctx context.Context, w *EventWebhook) (*EventWebhook, error)
+	// GetEventWebhook retrieves an event webhook by its ID.
+	GetEventWebhook(ctx context.Context, id string) (*EventWebhook, error)
+	// UpdateEventWebhook updates an existing event webhook by its ID.
+	UpdateEventWebhook(ctx context.Context, id string, w *EventWebhook) (*EventWebhook, error)
+	// DeleteEventWebhook deletes an event webhook by its ID.
+	DeleteEventWebhook(ctx context.Context, id string) error
+	// ListEventWebhooks retrieves all event webhooks.
+	ListEventWebhooks(ctx context.Context) ([]EventWebhook, error)
 }
 
 // BackoffConfig defines the configuration for retrying transient errors.
@@ -87,8 +240,36 @@ type Client struct {
 	HTTPClient *http.Client
 	Backoff    BackoffConfig
 	Limit      int
+	// PublishMode is the default way resources publish feature changes, PublishModeLive or PublishModeDraft.
+	PublishMode string
+	// WriteLockScope tells which writes are serialized, WriteLockObject, WriteLockCollection or WriteLockGlobal.
+	WriteLockScope string
+
+	cache *responseCache
+}
+
+// ListFilter narrows list requests on the server, for the endpoints supporting it.
+type ListFilter struct {
+	// ProjectID only lists the objects of a project.
+	ProjectID string
+}
+
+// query returns the query string of the filter, e.g. "?projectId=prj_123".
+func (f ListFilter) query() string {
+	if f.ProjectID == "" {
+		return ""
+	}
+	return "?" + url.Values{"projectId": {f.ProjectID}}.Encode()
 }
 
+// Publish modes for feature changes.
+const (
+	// PublishModeLive writes changes to the live feature.
+	PublishModeLive = "live"
+	// PublishModeDraft stages changes of default values and rules as a draft revision awaiting review.
+	PublishModeDraft = "draft"
+)
+
 // NewClient creates a Client with optional configuration options.
 func NewClient(baseURL, apiKey string, opts ...Option) ClientAPI {
 	client := &Client{
@@ -101,7 +282,10 @@ func NewClient(baseURL, apiKey string, opts ...Option) ClientAPI {
 			Multiplier:      2.0,
 			MaxInterval:     5 * time.Second,
 		},
-		Limit: 100,
+		Limit:          100,
+		PublishMode:    PublishModeLive,
+		WriteLockScope: WriteLockObject,
+		cache:          newResponseCache(),
 	}
 	for _, opt := range opts {
 		opt(client)
@@ -126,6 +310,20 @@ func WithPageLimit(limit int) Option {
 	}
 }
 
+// WithPublishMode sets the default publish mode of feature changes.
+func WithPublishMode(mode string) Option {
+	return func(c *Client) {
+		c.PublishMode = mode
+	}
+}
+
+// WithWriteLockScope sets which writes are serialized, see WriteLockObject, WriteLockCollection and WriteLockGlobal.
+func WithWriteLockScope(scope string) Option {
+	return func(c *Client) {
+		c.WriteLockScope = scope
+	}
+}
+
 // WithBackoff sets a custom backoff configuration for transient error retries.
 func WithBackoff(cfg BackoffConfig) Option {
 	return func(c *Client) {
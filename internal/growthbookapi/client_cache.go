@@ -0,0 +1,89 @@
+package growthbookapi
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// responseCache keeps list responses for the lifetime of a Client, i.e. a single Terraform run, so that refreshing
+// many resources of the same type costs a single list call. Entries are grouped by collection, the first segment of
+// the request path, and a collection is dropped whenever a request writes to it.
+type responseCache struct {
+	mu          sync.Mutex
+	collections map[string]map[string]*cacheEntry
+}
+
+// cacheEntry holds one response, encoded as JSON. Its mutex is held while loading, so concurrent readers wait for the
+// first one.
+type cacheEntry struct {
+	mu     sync.Mutex
+	loaded bool
+	value  []byte
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{collections: map[string]map[string]*cacheEntry{}}
+}
+
+// collectionOf returns the collection of a request path, e.g. "features" for "/features/my-feature?limit=10".
+func collectionOf(path string) string {
+	collection, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	collection, _, _ = strings.Cut(collection, "?")
+	return collection
+}
+
+func (rc *responseCache) entry(path string) *cacheEntry {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	collection := collectionOf(path)
+	entries, ok := rc.collections[collection]
+	if !ok {
+		entries = map[string]*cacheEntry{}
+		rc.collections[collection] = entries
+	}
+	e, ok := entries[path]
+	if !ok {
+		e = &cacheEntry{}
+		entries[path] = e
+	}
+	return e
+}
+
+// invalidate drops the cached responses of the collection of path. Loads in progress complete on the dropped
+// entries, so their possibly stale results are not served afterwards.
+func (rc *responseCache) invalidate(path string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	delete(rc.collections, collectionOf(path))
+}
+
+// cached returns the response cached for path, calling load on the first call only.
+// Every call decodes its own copy of the response, so callers may modify what they get without corrupting the
+// cache. Errors are not cached. The Client has no cache when it was not built by NewClient.
+func cached[T any](c *Client, path string, load func() (T, error)) (T, error) {
+	if c.cache == nil {
+		return load()
+	}
+
+	e := c.cache.entry(path)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.loaded {
+		v, err := load()
+		if err != nil {
+			return v, err
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return v, err
+		}
+		e.value, e.loaded = b, true
+	}
+	var out T
+	if err := json.Unmarshal(e.value, &out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
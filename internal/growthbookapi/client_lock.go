@@ -0,0 +1,112 @@
+package growthbookapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Write lock scopes, from the most to the least parallel.
+const (
+	// WriteLockObject serializes the writes of a single object, and of whole array-backed collections.
+	WriteLockObject = "object"
+	// WriteLockCollection serializes the writes of each collection, e.g. all features.
+	WriteLockCollection = "collection"
+	// WriteLockGlobal serializes all writes.
+	WriteLockGlobal = "global"
+)
+
+var (
+	// arrayCollections are stored by GrowthBook as a single array in the organization and rewritten by every write,
+	// so concurrent writes of different objects lose data.
+	//nolint:gochecknoglobals
+	arrayCollections = []string{"attributes", "environments"}
+	// writeLocks is shared by all Client instances, so provider aliases writing to the same organization
+	// are serialized too. Keys are prefixed by the organization, see Client.writeLockKey.
+	//nolint:gochecknoglobals
+	writeLocks = newLockManager()
+)
+
+// writeLockHeldKey marks contexts of requests sent while the caller already holds the write lock of the given key,
+// e.g. during a read-modify-write cycle.
+type writeLockHeldKey struct{}
+
+// lockManager hands out one mutex per key. Unused mutexes are dropped so the map does not grow with every object.
+type lockManager struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func newLockManager() *lockManager {
+	return &lockManager{locks: map[string]*keyLock{}}
+}
+
+// lock blocks until the mutex of key is acquired and returns the function releasing it.
+func (m *lockManager) lock(key string) func() {
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+		m.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
+
+// writeLockKey returns the key serializing the writes to path, or "" when they need no lock,
+// e.g. "features/my-feature" for "/features/my-feature/toggle" with the object scope. Keys are prefixed by
+// writeLockOrganization, so the scopes only apply within an organization and writes to others never wait.
+func (c *Client) writeLockKey(path string) string {
+	org := c.writeLockOrganization()
+	if c.WriteLockScope == WriteLockGlobal {
+		return org + "*"
+	}
+	path, _, _ = strings.Cut(strings.TrimPrefix(path, "/"), "?")
+	collection, rest, _ := strings.Cut(path, "/")
+	if c.WriteLockScope == WriteLockCollection || slices.Contains(arrayCollections, collection) {
+		return org + collection
+	}
+	id, _, _ := strings.Cut(rest, "/")
+	if id == "" {
+		// creations of distinct objects do not conflict
+		return ""
+	}
+	return org + collection + "/" + id
+}
+
+// writeLockOrganization returns the prefix of the write lock keys of the organization of the client, identified by
+// its base URL and API key. The key is hashed so that it is not kept in the lock table.
+func (c *Client) writeLockOrganization() string {
+	sum := sha256.Sum256([]byte(c.APIKey))
+	return c.BaseURL + "#" + hex.EncodeToString(sum[:8]) + ":"
+}
+
+// lockWrite acquires the write lock of path unless ctx shows the caller already holds it.
+// The returned context marks the lock as held, for the requests of a read-modify-write cycle.
+func (c *Client) lockWrite(ctx context.Context, path string) (context.Context, func()) {
+	key := c.writeLockKey(path)
+	if key == "" || ctx.Value(writeLockHeldKey{}) == key {
+		return ctx, func() {}
+	}
+	unlock := writeLocks.lock(key)
+	return context.WithValue(ctx, writeLockHeldKey{}, key), unlock
+}
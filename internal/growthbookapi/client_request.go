@@ -11,7 +11,6 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -34,11 +33,6 @@ var (
 		"DELETE": deleteStatuses,
 		"PATCH":  updateStatuses,
 	}
-	// globalWriteMu serializes all mutating API requests across all Client instances.
-	// GrowthBook stores some resources (attributes, environments) as arrays and performs
-	// read-modify-write internally, causing data loss under concurrent writes.
-	//nolint:gochecknoglobals
-	globalWriteMu sync.Mutex
 )
 
 func checkStatuses(method string, resp *http.Response) error {
@@ -81,20 +75,22 @@ func decodeResultKey[T any](m map[string]any, key string) (T, error) {
 // 1. read response bod for logging purposes, replace it with NopCloser buffer.
 func (c *Client) do(ctx context.Context, method, path string, body any) (*http.Response, error) {
 	if method != "GET" {
-		globalWriteMu.Lock()
-		defer globalWriteMu.Unlock()
+		var unlock func()
+		ctx, unlock = c.lockWrite(ctx, path)
+		defer unlock()
+		if c.cache != nil {
+			defer c.cache.invalidate(path)
+		}
 	}
 
-	var buf io.Reader
-	var bodyLog string
+	var payload []byte
 
 	if body != nil {
 		b, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		buf = bytes.NewBuffer(b)
-		bodyLog = string(b)
+		payload = b
 	}
 	url := c.BaseURL + path
 	redactedAPIKey := redactAPIKey(c.APIKey)
@@ -104,11 +100,11 @@ func (c *Client) do(ctx context.Context, method, path string, body any) (*http.R
 			"method":        method,
 			"url":           url,
 			"authorization": "Bearer " + redactedAPIKey,
-			"body":          bodyLog,
+			"body":          string(payload),
 		},
 	)
 
-	resp, err := c.withRetry(ctx, method, url, buf)
+	resp, err := c.withRetry(ctx, method, url, payload)
 	if err != nil {
 		tflog.Debug(ctx,
 			"HTTP Response Error",
@@ -168,15 +164,22 @@ func (c *Client) retryAfter(
 	return interval
 }
 
-// 1. success or non-retryable error, exit reties and return.
-// 2. close response body as it won't be closed by caller.
-func (c *Client) withRetry(ctx context.Context, method, url string, buf io.Reader) (*http.Response, error) {
+// 1. the body reader is consumed by each attempt, build a new one from the payload every time.
+// 2. rate limited, wait as requested by the server then retry.
+// 3. success or non-retryable error, exit reties and return.
+// 4. close response body as it won't be closed by caller.
+func (c *Client) withRetry(ctx context.Context, method, url string, payload []byte) (*http.Response, error) {
 	var resp *http.Response
 	var err error
 
 	attempt := 0
 	interval := c.Backoff.InitialInterval
 	for {
+		// 1.
+		var buf io.Reader
+		if payload != nil {
+			buf = bytes.NewReader(payload)
+		}
 		req, reqErr := http.NewRequestWithContext(ctx, method, url, buf)
 		if reqErr != nil {
 			return nil, reqErr
@@ -187,11 +190,15 @@ func (c *Client) withRetry(ctx context.Context, method, url string, buf io.Reade
 		resp, err = c.HTTPClient.Do(req)
 
 		if err == nil {
-			if resp.StatusCode == http.StatusTooManyRequests {
+			if resp.StatusCode == http.StatusTooManyRequests && attempt < c.Backoff.MaxRetries {
+				// 2.
 				interval = c.retryAfter(ctx, resp, attempt, interval, c.Backoff.MaxInterval)
+				_ = resp.Body.Close()
+				attempt++
+				continue
 			}
 			if resp.StatusCode < 500 {
-				// 1.
+				// 3.
 				break
 			}
 		}
@@ -219,8 +226,8 @@ func (c *Client) withRetry(ctx context.Context, method, url string, buf io.Reade
 		})
 		time.Sleep(interval)
 		interval = time.Duration(math.Min(float64(c.Backoff.MaxInterval), float64(interval)*c.Backoff.Multiplier))
-		// 2.
-		if err != nil {
+		// 4.
+		if err == nil {
 			_ = resp.Body.Close()
 		}
 	}
@@ -235,6 +242,9 @@ func (c *Client) delete(ctx context.Context, path string) error {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
 	for _, code := range deleteStatuses {
 		if resp.StatusCode == code {
 			return nil
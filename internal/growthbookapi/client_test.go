@@ -0,0 +1,721 @@
+package growthbookapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+	"terraform-provider-growthbook/internal/growthbookapi/apitest"
+)
+
+func newTestClient(t *testing.T, opts ...growthbookapi.Option) (*growthbookapi.Client, *apitest.Server) {
+	t.Helper()
+
+	srv := apitest.NewServer()
+	t.Cleanup(srv.Close)
+
+	opts = append([]growthbookapi.Option{
+		growthbookapi.WithBackoff(growthbookapi.BackoffConfig{
+			MaxRetries:      3,
+			InitialInterval: time.Millisecond,
+			Multiplier:      2.0,
+			MaxInterval:     5 * time.Millisecond,
+		}),
+	}, opts...)
+	client, ok := growthbookapi.NewClient(srv.APIURL(), srv.APIKey, opts...).(*growthbookapi.Client)
+	if !ok {
+		t.Fatal("NewClient did not return a *growthbookapi.Client")
+	}
+	return client, srv
+}
+
+func countRequests(srv *apitest.Server, prefix string) int {
+	n := 0
+	for _, r := range srv.Requests() {
+		if strings.HasPrefix(r, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestClient_pagination(t *testing.T) {
+	t.Parallel()
+
+	client, srv := newTestClient(t, growthbookapi.WithPageLimit(10))
+	for i := range 25 {
+		srv.Seed("projects", map[string]any{"name": fmt.Sprintf("project-%02d", i)})
+	}
+
+	p, err := client.FindProjectByName(context.Background(), "project-24")
+	if err != nil {
+		t.Fatalf("FindProjectByName: %v", err)
+	}
+	if p.Name != "project-24" {
+		t.Errorf("got project %q, want project-24", p.Name)
+	}
+	if got := countRequests(srv, "GET /api/v1/projects?"); got != 3 {
+		t.Errorf("got %d list requests, want 3: %v", got, srv.Requests())
+	}
+}
+
+func TestClient_listCache(t *testing.T) {
+	t.Parallel()
+
+	client, srv := newTestClient(t)
+	srv.Seed("projects", map[string]any{"name": "first"}, map[string]any{"name": "second"})
+
+	// concurrent lookups, e.g. resources refreshed in parallel, share a single list call
+	var wg sync.WaitGroup
+	for _, name := range []string{"first", "second", "first", "second"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.FindProjectByName(context.Background(), name); err != nil {
+				t.Errorf("FindProjectByName(%q): %v", name, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := countRequests(srv, "GET /api/v1/projects?"); got != 1 {
+		t.Errorf("got %d list requests, want 1: %v", got, srv.Requests())
+	}
+
+	// a write to the collection drops the cached list
+	if _, err := client.CreateProject(context.Background(), &growthbookapi.Project{Name: "third"}); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	if _, err := client.FindProjectByName(context.Background(), "third"); err != nil {
+		t.Fatalf("FindProjectByName(third): %v", err)
+	}
+	if got := countRequests(srv, "GET /api/v1/projects?"); got != 2 {
+		t.Errorf("got %d list requests, want 2: %v", got, srv.Requests())
+	}
+
+	// other collections keep their cache
+	if _, err := client.ListEnvironments(context.Background()); err != nil {
+		t.Fatalf("ListEnvironments: %v", err)
+	}
+	if _, err := client.CreateProject(context.Background(), &growthbookapi.Project{Name: "fourth"}); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	if _, err := client.ListEnvironments(context.Background()); err != nil {
+		t.Fatalf("ListEnvironments: %v", err)
+	}
+	if got := countRequests(srv, "GET /api/v1/environments"); got != 1 {
+		t.Errorf("got %d environment requests, want 1: %v", got, srv.Requests())
+	}
+}
+
+// Cached lists are copied for every caller, so modifying a result does not change later reads.
+func TestClient_listCacheCopies(t *testing.T) {
+	t.Parallel()
+
+	client, srv := newTestClient(t)
+	srv.Seed("features", map[string]any{
+		"id":   "checkout",
+		"tags": []any{"web"},
+		"environments": map[string]any{
+			"prod": map[string]any{"enabled": true, "rules": []any{map[string]any{"id": "fr_1", "type": "force"}}},
+		},
+	})
+
+	features, err := client.ListFeatures(context.Background(), growthbookapi.ListFilter{})
+	if err != nil {
+		t.Fatalf("ListFeatures: %v", err)
+	}
+	features[0].Tags[0] = "changed"
+	features[0].Environments["prod"].Rules[0].ID = "changed"
+	features[0].Environments["dev"] = growthbookapi.FeatureEnvironmentConfig{}
+
+	features, err = client.ListFeatures(context.Background(), growthbookapi.ListFilter{})
+	if err != nil {
+		t.Fatalf("ListFeatures: %v", err)
+	}
+	f := features[0]
+	if f.Tags[0] != "web" || f.Environments["prod"].Rules[0].ID != "fr_1" || len(f.Environments) != 1 {
+		t.Errorf("cached feature was modified through a previous result: %+v", f)
+	}
+	if got := countRequests(srv, "GET /api/v1/features?"); got != 1 {
+		t.Errorf("got %d list requests, want 1: %v", got, srv.Requests())
+	}
+}
+
+func TestClient_listFilter(t *testing.T) {
+	t.Parallel()
+
+	client, srv := newTestClient(t)
+	srv.Seed("features",
+		map[string]any{"id": "in-project", "project": "prj_a"},
+		map[string]any{"id": "elsewhere", "project": "prj_b"},
+	)
+
+	features, err := client.ListFeatures(context.Background(), growthbookapi.ListFilter{ProjectID: "prj_a"})
+	if err != nil {
+		t.Fatalf("ListFeatures: %v", err)
+	}
+	if len(features) != 1 || features[0].ID != "in-project" {
+		t.Errorf("got features %v, want only in-project", features)
+	}
+	if got := countRequests(srv, "GET /api/v1/features?limit=100&projectId=prj_a"); got != 1 {
+		t.Errorf("got %d filtered requests, want 1: %v", got, srv.Requests())
+	}
+
+	// lookups by feature ID use the direct endpoint rather than the list
+	if _, err := client.FindFeatureByName(context.Background(), "elsewhere"); err != nil {
+		t.Fatalf("FindFeatureByName: %v", err)
+	}
+	if got := countRequests(srv, "GET /api/v1/features/elsewhere"); got != 1 {
+		t.Errorf("got %d feature requests, want 1: %v", got, srv.Requests())
+	}
+}
+
+// concurrencyTransport records the peak number of concurrent writes per collection, and overall under "*".
+// Each write lasts at least delay so that unserialized writes overlap.
+type concurrencyTransport struct {
+	delay time.Duration
+
+	mu       sync.Mutex
+	inFlight map[string]int
+	peak     map[string]int
+}
+
+func (tr *concurrencyTransport) track(key string, delta int) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.inFlight[key] += delta
+	tr.peak[key] = max(tr.peak[key], tr.inFlight[key])
+}
+
+func (tr *concurrencyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodGet {
+		return http.DefaultTransport.RoundTrip(r)
+	}
+	collection, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	for _, key := range []string{collection, "*"} {
+		tr.track(key, 1)
+		defer tr.track(key, -1)
+	}
+	time.Sleep(tr.delay)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClient_writeLocks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scope string
+		// wantSerialized lists the keys of concurrencyTransport whose writes must never overlap,
+		// wantParallel those whose writes must overlap.
+		wantSerialized []string
+		wantParallel   []string
+	}{
+		{
+			scope:          growthbookapi.WriteLockObject,
+			wantSerialized: []string{"environments", "attributes"},
+			wantParallel:   []string{"projects", "features"},
+		},
+		{
+			scope:          growthbookapi.WriteLockCollection,
+			wantSerialized: []string{"environments", "attributes", "projects", "features"},
+			wantParallel:   []string{"*"},
+		},
+		{
+			scope:          growthbookapi.WriteLockGlobal,
+			wantSerialized: []string{"*"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &concurrencyTransport{delay: 20 * time.Millisecond, inFlight: map[string]int{}, peak: map[string]int{}}
+			client, _ := newTestClient(t,
+				growthbookapi.WithHTTPClient(&http.Client{Transport: tr}),
+				growthbookapi.WithWriteLockScope(tt.scope),
+			)
+			ctx := context.Background()
+
+			const n = 6
+			var wg sync.WaitGroup
+			for i := range n {
+				writes := []func() error{
+					func() error {
+						_, err := client.CreateEnvironment(ctx, &growthbookapi.Environment{ID: fmt.Sprintf("env-%d", i)})
+						return err
+					},
+					func() error {
+						_, err := client.CreateAttribute(ctx, &growthbookapi.Attribute{
+							Property: fmt.Sprintf("attr-%d", i), DataType: "string",
+						})
+						return err
+					},
+					func() error {
+						_, err := client.CreateProject(ctx, &growthbookapi.Project{Name: fmt.Sprintf("project-%d", i)})
+						return err
+					},
+					func() error {
+						_, err := client.CreateFeature(ctx, &growthbookapi.Feature{
+							ID: fmt.Sprintf("feature-%d", i), ValueType: "boolean",
+						})
+						return err
+					},
+				}
+				for _, write := range writes {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						if err := write(); err != nil {
+							t.Errorf("write: %v", err)
+						}
+					}()
+				}
+			}
+			wg.Wait()
+
+			for _, key := range tt.wantSerialized {
+				if tr.peak[key] != 1 {
+					t.Errorf("%s: got %d concurrent writes, want 1", key, tr.peak[key])
+				}
+			}
+			for _, key := range tt.wantParallel {
+				if tr.peak[key] < 2 {
+					t.Errorf("%s: got %d concurrent writes, want several", key, tr.peak[key])
+				}
+			}
+
+			// no write to the array-backed collections was lost
+			envs, err := client.ListEnvironments(ctx)
+			if err != nil {
+				t.Fatalf("ListEnvironments: %v", err)
+			}
+			attributes, err := client.ListAttributes(ctx)
+			if err != nil {
+				t.Fatalf("ListAttributes: %v", err)
+			}
+			if len(envs) != n || len(attributes) != n {
+				t.Errorf("got %d environments and %d attributes, want %d of each", len(envs), len(attributes), n)
+			}
+		})
+	}
+}
+
+// Write locks are scoped to an organization: clients of the same organization, e.g. provider aliases, share them,
+// and clients of different organizations never wait for each other.
+func TestClient_writeLocksPerOrganization(t *testing.T) {
+	t.Parallel()
+
+	tr := &concurrencyTransport{delay: 20 * time.Millisecond, inFlight: map[string]int{}, peak: map[string]int{}}
+	opts := []growthbookapi.Option{
+		growthbookapi.WithHTTPClient(&http.Client{Transport: tr}),
+		growthbookapi.WithWriteLockScope(growthbookapi.WriteLockGlobal),
+	}
+	first, srv := newTestClient(t, opts...)
+	alias := growthbookapi.NewClient(srv.APIURL(), srv.APIKey, opts...)
+	other, _ := newTestClient(t, opts...)
+
+	write := func(clients ...growthbookapi.ClientAPI) int {
+		tr.mu.Lock()
+		tr.peak = map[string]int{}
+		tr.mu.Unlock()
+
+		var wg sync.WaitGroup
+		for i, client := range clients {
+			for j := range 3 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					name := fmt.Sprintf("project-%d-%d", i, j)
+					if _, err := client.CreateProject(context.Background(), &growthbookapi.Project{Name: name}); err != nil {
+						t.Errorf("CreateProject: %v", err)
+					}
+				}()
+			}
+		}
+		wg.Wait()
+		return tr.peak["*"]
+	}
+
+	if got := write(first, alias); got != 1 {
+		t.Errorf("same organization: got %d concurrent writes, want 1", got)
+	}
+	if got := write(first, other); got < 2 {
+		t.Errorf("different organizations: got %d concurrent writes, want several", got)
+	}
+}
+
+func TestClient_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+
+	if _, err := client.GetProject(context.Background(), "prj_missing"); !errors.Is(err, growthbookapi.ErrNotFound) {
+		t.Errorf("GetProject: got %v, want ErrNotFound", err)
+	}
+	if err := client.DeleteProject(context.Background(), "prj_missing"); !errors.Is(err, growthbookapi.ErrNotFound) {
+		t.Errorf("DeleteProject: got %v, want ErrNotFound", err)
+	}
+	if _, err := client.FindProjectByName(context.Background(), "missing"); !errors.Is(err, growthbookapi.ErrNotFound) {
+		t.Errorf("FindProjectByName: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestClient_retries(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			t.Parallel()
+
+			client, srv := newTestClient(t)
+			srv.Inject(apitest.Fault{Method: "POST", Path: "/projects", Status: status, Times: 2})
+
+			// the request body must be sent again on every attempt
+			p, err := client.CreateProject(context.Background(), &growthbookapi.Project{Name: "retried"})
+			if err != nil {
+				t.Fatalf("CreateProject: %v", err)
+			}
+			if p.Name != "retried" {
+				t.Errorf("got project %q, want retried", p.Name)
+			}
+			if got := countRequests(srv, "POST /api/v1/projects"); got != 3 {
+				t.Errorf("got %d requests, want 3: %v", got, srv.Requests())
+			}
+		})
+	}
+}
+
+func TestClient_retriesExhausted(t *testing.T) {
+	t.Parallel()
+
+	client, srv := newTestClient(t)
+	srv.Inject(apitest.Fault{Path: "/projects", Status: http.StatusInternalServerError, Times: 10})
+
+	if _, err := client.GetProject(context.Background(), "prj_any"); err == nil {
+		t.Fatal("GetProject: expected an error")
+	}
+	// one attempt plus MaxRetries retries
+	if got := countRequests(srv, "GET /api/v1/projects/prj_any"); got != 4 {
+		t.Errorf("got %d requests, want 4: %v", got, srv.Requests())
+	}
+}
+
+func TestClient_updateFeatureEnvironment(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateFeature(ctx, &growthbookapi.Feature{
+		ID:           "checkout",
+		ValueType:    "boolean",
+		DefaultValue: "false",
+		Environments: map[string]growthbookapi.FeatureEnvironmentConfig{
+			"dev":  {Enabled: true, Rules: []growthbookapi.FeatureRule{{Type: "force", Value: "true"}}},
+			"prod": {Enabled: false},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateFeature: %v", err)
+	}
+
+	// concurrent writes to different environments must not overwrite each other
+	errs := make(chan error, 2)
+	for _, env := range []string{"dev", "prod"} {
+		go func() {
+			_, err := client.UpdateFeatureEnvironment(ctx, "checkout", env, growthbookapi.FeatureEnvironmentConfig{
+				Enabled: true,
+				Rules:   []growthbookapi.FeatureRule{{Type: "force", Value: "true", Description: env}},
+			})
+			errs <- err
+		}()
+	}
+	for range 2 {
+		if err := <-errs; err != nil {
+			t.Fatalf("UpdateFeatureEnvironment: %v", err)
+		}
+	}
+
+	f, err := client.GetFeature(ctx, "checkout")
+	if err != nil {
+		t.Fatalf("GetFeature: %v", err)
+	}
+	for _, env := range []string{"dev", "prod"} {
+		cfg := f.Environments[env]
+		if !cfg.Enabled || len(cfg.Rules) != 1 || cfg.Rules[0].Description != env {
+			t.Errorf("environment %s: got %+v", env, cfg)
+		}
+	}
+}
+
+func ruleIDs(t *testing.T, client *growthbookapi.Client, env string) []string {
+	t.Helper()
+
+	f, err := client.GetFeature(context.Background(), "checkout")
+	if err != nil {
+		t.Fatalf("GetFeature: %v", err)
+	}
+	var ids []string
+	for _, r := range f.Environments[env].Rules {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestClient_featureRules(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateFeature(ctx, &growthbookapi.Feature{
+		ID:        "checkout",
+		ValueType: "boolean",
+		Environments: map[string]growthbookapi.FeatureEnvironmentConfig{
+			"prod": {Enabled: true, Rules: []growthbookapi.FeatureRule{{ID: "fr_shared", Type: "force", Value: "true"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateFeature: %v", err)
+	}
+
+	first, err := client.CreateFeatureRule(ctx, "checkout", "prod",
+		growthbookapi.FeatureRule{Type: "force", Value: "false"},
+		growthbookapi.RulePlacement{Position: growthbookapi.RulePositionFirst})
+	if err != nil {
+		t.Fatalf("CreateFeatureRule: %v", err)
+	}
+	after, err := client.CreateFeatureRule(ctx, "checkout", "prod",
+		growthbookapi.FeatureRule{Type: "force", Value: "true"},
+		growthbookapi.RulePlacement{AfterID: first.ID})
+	if err != nil {
+		t.Fatalf("CreateFeatureRule: %v", err)
+	}
+	if got, want := strings.Join(ruleIDs(t, client, "prod"), ","), first.ID+","+after.ID+",fr_shared"; got != want {
+		t.Errorf("got rules %s, want %s", got, want)
+	}
+
+	// updates keep the position unless a placement is given
+	first.Value = "true"
+	if _, err := client.UpdateFeatureRule(ctx, "checkout", "prod", *first, nil); err != nil {
+		t.Fatalf("UpdateFeatureRule: %v", err)
+	}
+	if got := ruleIDs(t, client, "prod")[0]; got != first.ID {
+		t.Errorf("got first rule %s, want %s", got, first.ID)
+	}
+	_, err = client.UpdateFeatureRule(ctx, "checkout", "prod", *first,
+		&growthbookapi.RulePlacement{Position: growthbookapi.RulePositionLast})
+	if err != nil {
+		t.Fatalf("UpdateFeatureRule: %v", err)
+	}
+	if got, want := strings.Join(ruleIDs(t, client, "prod"), ","), after.ID+",fr_shared,"+first.ID; got != want {
+		t.Errorf("got rules %s, want %s", got, want)
+	}
+
+	if err := client.DeleteFeatureRule(ctx, "checkout", "prod", after.ID); err != nil {
+		t.Fatalf("DeleteFeatureRule: %v", err)
+	}
+	if err := client.DeleteFeatureRule(ctx, "checkout", "prod", after.ID); !errors.Is(err, growthbookapi.ErrNotFound) {
+		t.Errorf("DeleteFeatureRule: got %v, want ErrNotFound", err)
+	}
+	if got, want := strings.Join(ruleIDs(t, client, "prod"), ","), "fr_shared,"+first.ID; got != want {
+		t.Errorf("got rules %s, want %s", got, want)
+	}
+}
+
+func TestClient_featureRulesConcurrent(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	if _, err := client.CreateFeature(ctx, &growthbookapi.Feature{ID: "checkout", ValueType: "boolean"}); err != nil {
+		t.Fatalf("CreateFeature: %v", err)
+	}
+
+	const n = 10
+	errs := make(chan error, n)
+	for range n {
+		go func() {
+			_, err := client.CreateFeatureRule(ctx, "checkout", "prod",
+				growthbookapi.FeatureRule{Type: "force", Value: "true"}, growthbookapi.RulePlacement{})
+			errs <- err
+		}()
+	}
+	for range n {
+		if err := <-errs; err != nil {
+			t.Fatalf("CreateFeatureRule: %v", err)
+		}
+	}
+	if got := len(ruleIDs(t, client, "prod")); got != n {
+		t.Errorf("got %d rules, want %d", got, n)
+	}
+}
+
+func TestClient_featureRevisions(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateFeature(ctx, &growthbookapi.Feature{
+		ID:           "checkout",
+		ValueType:    "boolean",
+		DefaultValue: "false",
+		Environments: map[string]growthbookapi.FeatureEnvironmentConfig{"prod": {Enabled: false}},
+	})
+	if err != nil {
+		t.Fatalf("CreateFeature: %v", err)
+	}
+
+	draft := func() *growthbookapi.FeatureRevision {
+		rev, err := client.CreateFeatureRevision(ctx, "checkout", &growthbookapi.FeatureRevision{
+			Comment:      "rollout",
+			DefaultValue: "true",
+			Environments: map[string]growthbookapi.FeatureDraft{"prod": {Enabled: true}},
+		})
+		if err != nil {
+			t.Fatalf("CreateFeatureRevision: %v", err)
+		}
+		if rev.Status != growthbookapi.RevisionStatusDraft {
+			t.Errorf("got revision status %q, want draft", rev.Status)
+		}
+		return rev
+	}
+
+	// discarded drafts leave the live feature untouched
+	discarded := draft()
+	if _, err := client.DiscardFeatureRevision(ctx, "checkout", discarded.Version); err != nil {
+		t.Fatalf("DiscardFeatureRevision: %v", err)
+	}
+	f, err := client.GetFeature(ctx, "checkout")
+	if err != nil {
+		t.Fatalf("GetFeature: %v", err)
+	}
+	if f.DefaultValue != "false" || f.Environments["prod"].Enabled {
+		t.Errorf("discarded draft changed the feature: %+v", f)
+	}
+
+	published := draft()
+	if _, err := client.PublishFeatureRevision(ctx, "checkout", published.Version); err != nil {
+		t.Fatalf("PublishFeatureRevision: %v", err)
+	}
+	f, err = client.GetFeature(ctx, "checkout")
+	if err != nil {
+		t.Fatalf("GetFeature: %v", err)
+	}
+	if f.DefaultValue != "true" || !f.Environments["prod"].Enabled {
+		t.Errorf("published draft was not applied: %+v", f)
+	}
+
+	revisions, err := client.ListFeatureRevisions(ctx, "checkout")
+	if err != nil {
+		t.Fatalf("ListFeatureRevisions: %v", err)
+	}
+	var statuses []string
+	for _, rev := range revisions {
+		statuses = append(statuses, rev.Status)
+		if rev.Open() {
+			t.Errorf("revision %d is still open", rev.Version)
+		}
+	}
+	if got := strings.Join(statuses, ","); got != "discarded,published" {
+		t.Errorf("got revision statuses %s, want discarded,published", got)
+	}
+}
+
+func TestClient_organizationSettings(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	engine, sticky := "frequentist", false
+	updated, err := client.UpdateOrganizationSettings(ctx, &growthbookapi.OrganizationSettings{
+		StatsEngine:        &engine,
+		UseStickyBucketing: &sticky,
+	})
+	if err != nil {
+		t.Fatalf("UpdateOrganizationSettings: %v", err)
+	}
+	if updated.StatsEngine == nil || *updated.StatsEngine != engine {
+		t.Errorf("statsEngine: got %v, want %q", updated.StatsEngine, engine)
+	}
+	if updated.UseStickyBucketing == nil || *updated.UseStickyBucketing {
+		t.Errorf("useStickyBucketing: got %v, want false", updated.UseStickyBucketing)
+	}
+
+	// settings left nil are not sent, so GrowthBook keeps them
+	regex := "^[a-z-]+$"
+	if _, err := client.UpdateOrganizationSettings(ctx, &growthbookapi.OrganizationSettings{
+		FeatureRegexValidator: &regex,
+	}); err != nil {
+		t.Fatalf("UpdateOrganizationSettings: %v", err)
+	}
+	settings, err := client.GetOrganizationSettings(ctx)
+	if err != nil {
+		t.Fatalf("GetOrganizationSettings: %v", err)
+	}
+	if settings.StatsEngine == nil || *settings.StatsEngine != engine {
+		t.Errorf("statsEngine: got %v, want %q", settings.StatsEngine, engine)
+	}
+	if settings.FeatureRegexValidator == nil || *settings.FeatureRegexValidator != regex {
+		t.Errorf("featureRegexValidator: got %v, want %q", settings.FeatureRegexValidator, regex)
+	}
+}
+
+func TestClient_updateSavedGroup(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateSavedGroup(ctx, &growthbookapi.SavedGroup{
+		Name:         "beta",
+		Type:         "list",
+		AttributeKey: "id",
+		Values:       []string{"a", "b"},
+		Description:  "beta testers",
+	})
+	if err != nil {
+		t.Fatalf("CreateSavedGroup: %v", err)
+	}
+
+	// fields left nil are not sent, so GrowthBook keeps them
+	updated, err := client.UpdateSavedGroup(ctx, created.ID, &growthbookapi.SavedGroupUpdateBody{Name: "beta-testers"})
+	if err != nil {
+		t.Fatalf("UpdateSavedGroup: %v", err)
+	}
+	if updated.Name != "beta-testers" {
+		t.Errorf("name: got %q, want beta-testers", updated.Name)
+	}
+	if len(updated.Values) != 2 {
+		t.Errorf("values: got %v, want [a b]", updated.Values)
+	}
+	if updated.Description != "beta testers" {
+		t.Errorf("description: got %q, want beta testers", updated.Description)
+	}
+
+	// empty fields that are set are sent, so they are cleared
+	description := ""
+	updated, err = client.UpdateSavedGroup(ctx, created.ID, &growthbookapi.SavedGroupUpdateBody{
+		Name:        "beta-testers",
+		Values:      &[]string{},
+		Description: &description,
+	})
+	if err != nil {
+		t.Fatalf("UpdateSavedGroup: %v", err)
+	}
+	if len(updated.Values) != 0 {
+		t.Errorf("values: got %v, want none", updated.Values)
+	}
+	if updated.Description != "" {
+		t.Errorf("description: got %q, want empty", updated.Description)
+	}
+}
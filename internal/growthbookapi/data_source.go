@@ -0,0 +1,75 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// DataSourceBody is the payload used to create or update a data source.
+// Params holds the connection settings (host, credentials, ...) and is write-only.
+type DataSourceBody struct {
+	Name              string                      `json:"name"`
+	Type              string                      `json:"type,omitempty"`
+	Description       string                      `json:"description,omitempty"`
+	ProjectIDs        []string                    `json:"projectIds"`
+	EventTracker      string                      `json:"eventTracker,omitempty"`
+	Params            map[string]string           `json:"params,omitempty"`
+	IdentifierTypes   []DataSourceIdentifierType  `json:"identifierTypes"`
+	AssignmentQueries []DataSourceAssignmentQuery `json:"assignmentQueries"`
+}
+
+func (b *DataSourceBody) normalize() {
+	if b.ProjectIDs == nil {
+		b.ProjectIDs = []string{}
+	}
+	if b.IdentifierTypes == nil {
+		b.IdentifierTypes = []DataSourceIdentifierType{}
+	}
+	if b.AssignmentQueries == nil {
+		b.AssignmentQueries = []DataSourceAssignmentQuery{}
+	}
+	for i := range b.AssignmentQueries {
+		if b.AssignmentQueries[i].DimensionColumns == nil {
+			b.AssignmentQueries[i].DimensionColumns = []string{}
+		}
+	}
+}
+
+// CreateDataSource creates a new data source in GrowthBook.
+func (c *Client) CreateDataSource(ctx context.Context, d *DataSourceBody) (*DataSource, error) {
+	d.normalize()
+	out, err := fetcher[DataSource](c, "POST", "/data-sources").One(ctx, d, "dataSource")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDataSource fetches a data source by its ID.
+func (c *Client) GetDataSource(ctx context.Context, id string) (*DataSource, error) {
+	out, err := fetcher[DataSource](c, "GET", "/data-sources/"+id).One(ctx, nil, "dataSource")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateDataSource updates an existing data source by its ID.
+// Connection params are only sent when non-empty, leaving the stored ones untouched otherwise.
+func (c *Client) UpdateDataSource(ctx context.Context, id string, d *DataSourceBody) (*DataSource, error) {
+	d.normalize()
+	out, err := fetcher[DataSource](c, "POST", "/data-sources/"+id).One(ctx, d, "dataSource")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteDataSource deletes a data source by its ID.
+func (c *Client) DeleteDataSource(ctx context.Context, id string) error {
+	return c.delete(ctx, "/data-sources/"+id)
+}
+
+// ListDataSources fetches all data sources, handling pagination.
+func (c *Client) ListDataSources(ctx context.Context) ([]DataSource, error) {
+	return fetcher[DataSource](c, "GET", "/data-sources").All(ctx, nil, "dataSources")
+}
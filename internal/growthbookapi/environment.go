@@ -27,9 +27,10 @@ func (c *Client) DeleteEnvironment(ctx context.Context, id string) error {
 	return c.delete(ctx, "/environments/"+id)
 }
 
-// FindEnvironmentByID fetches an environment by its ID by listing all and filtering.
+// FindEnvironmentByID fetches an environment by its ID. GrowthBook has no endpoint to get a single environment,
+// so the cached environment list is scanned.
 func (c *Client) FindEnvironmentByID(ctx context.Context, id string) (*Environment, error) {
-	envs, err := fetcher[[]Environment](c, "GET", "/environments").One(ctx, nil, "environments")
+	envs, err := c.ListEnvironments(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +42,9 @@ func (c *Client) FindEnvironmentByID(ctx context.Context, id string) (*Environme
 	return nil, ErrNotFound
 }
 
-// ListEnvironments fetches all environments.
+// ListEnvironments fetches all environments. The list is cached until an environment is written.
 func (c *Client) ListEnvironments(ctx context.Context) ([]Environment, error) {
-	return fetcher[Environment](c, "GET", "/environments").All(ctx, nil, "environments")
+	return cached(c, "/environments", func() ([]Environment, error) {
+		return fetcher[Environment](c, "GET", "/environments").All(ctx, nil, "environments")
+	})
 }
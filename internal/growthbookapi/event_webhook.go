@@ -0,0 +1,45 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// CreateEventWebhook creates a webhook called on GrowthBook events.
+func (c *Client) CreateEventWebhook(ctx context.Context, w *EventWebhook) (*EventWebhook, error) {
+	out, err := fetcher[EventWebhook](c, "POST", "/event-webhooks").One(ctx, w, "eventWebhook")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEventWebhook fetches an event webhook by its ID.
+func (c *Client) GetEventWebhook(ctx context.Context, id string) (*EventWebhook, error) {
+	out, err := fetcher[EventWebhook](c, "GET", "/event-webhooks/"+id).One(ctx, nil, "eventWebhook")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateEventWebhook updates an existing event webhook by its ID.
+func (c *Client) UpdateEventWebhook(ctx context.Context, id string, w *EventWebhook) (*EventWebhook, error) {
+	out, err := fetcher[EventWebhook](c, "PUT", "/event-webhooks/"+id).One(ctx, w, "eventWebhook")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEventWebhook deletes an event webhook by its ID.
+func (c *Client) DeleteEventWebhook(ctx context.Context, id string) error {
+	return c.delete(ctx, "/event-webhooks/"+id)
+}
+
+// ListEventWebhooks fetches all event webhooks, handling pagination. The list is cached until an event webhook is
+// written.
+func (c *Client) ListEventWebhooks(ctx context.Context) ([]EventWebhook, error) {
+	return cached(c, "/event-webhooks", func() ([]EventWebhook, error) {
+		return fetcher[EventWebhook](c, "GET", "/event-webhooks").All(ctx, nil, "eventWebhooks")
+	})
+}
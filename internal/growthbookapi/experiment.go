@@ -0,0 +1,104 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// ExperimentBody is the payload used to create or update an experiment.
+// Unlike Experiment, metrics are plain ID lists and variations may omit their ID on creation.
+type ExperimentBody struct {
+	TrackingKey       string                    `json:"trackingKey"`
+	Name              string                    `json:"name"`
+	Project           string                    `json:"project,omitempty"`
+	Hypothesis        string                    `json:"hypothesis,omitempty"`
+	Description       string                    `json:"description,omitempty"`
+	Tags              []string                  `json:"tags"`
+	Owner             string                    `json:"owner,omitempty"`
+	Archived          bool                      `json:"archived"`
+	Status            string                    `json:"status,omitempty"`
+	HashAttribute     string                    `json:"hashAttribute,omitempty"`
+	DatasourceID      string                    `json:"datasourceId,omitempty"`
+	AssignmentQueryID string                    `json:"assignmentQueryId,omitempty"`
+	Metrics           []string                  `json:"metrics"`
+	SecondaryMetrics  []string                  `json:"secondaryMetrics"`
+	GuardrailMetrics  []string                  `json:"guardrailMetrics"`
+	Variations        []ExperimentVariationBody `json:"variations"`
+	Phases            []ExperimentPhaseBody     `json:"phases,omitempty"`
+}
+
+// ExperimentVariationBody is a variation in an experiment payload. ID is empty for new variations.
+type ExperimentVariationBody struct {
+	ID          string `json:"id,omitempty"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// ExperimentPhaseBody is a phase in an experiment payload.
+// VariationWeights are given in the same order as the experiment variations.
+type ExperimentPhaseBody struct {
+	Name              string    `json:"name"`
+	DateStarted       string    `json:"dateStarted,omitempty"`
+	DateEnded         string    `json:"dateEnded,omitempty"`
+	ReasonForStopping string    `json:"reasonForStopping,omitempty"`
+	Coverage          *float64  `json:"coverage,omitempty"`
+	VariationWeights  []float64 `json:"variationWeights,omitempty"`
+	Condition         string    `json:"condition,omitempty"`
+}
+
+func (b *ExperimentBody) normalize() {
+	if b.Tags == nil {
+		b.Tags = []string{}
+	}
+	if b.Metrics == nil {
+		b.Metrics = []string{}
+	}
+	if b.SecondaryMetrics == nil {
+		b.SecondaryMetrics = []string{}
+	}
+	if b.GuardrailMetrics == nil {
+		b.GuardrailMetrics = []string{}
+	}
+}
+
+// CreateExperiment creates a new experiment in GrowthBook.
+func (c *Client) CreateExperiment(ctx context.Context, e *ExperimentBody) (*Experiment, error) {
+	e.normalize()
+	out, err := fetcher[Experiment](c, "POST", "/experiments").One(ctx, e, "experiment")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExperiment fetches an experiment by its ID.
+func (c *Client) GetExperiment(ctx context.Context, id string) (*Experiment, error) {
+	out, err := fetcher[Experiment](c, "GET", "/experiments/"+id).One(ctx, nil, "experiment")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateExperiment updates an existing experiment by its ID.
+func (c *Client) UpdateExperiment(ctx context.Context, id string, e *ExperimentBody) (*Experiment, error) {
+	e.normalize()
+	out, err := fetcher[Experiment](c, "POST", "/experiments/"+id).One(ctx, e, "experiment")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ArchiveExperiment archives an experiment by its ID.
+// The GrowthBook API does not allow deleting experiments, archiving is the closest equivalent.
+func (c *Client) ArchiveExperiment(ctx context.Context, id string) error {
+	body := map[string]any{"archived": true}
+	_, err := fetcher[Experiment](c, "POST", "/experiments/"+id).One(ctx, body, "experiment")
+	return err
+}
+
+// ListExperiments fetches all experiments, handling pagination.
+func (c *Client) ListExperiments(ctx context.Context) ([]Experiment, error) {
+	return fetcher[Experiment](c, "GET", "/experiments").All(ctx, nil, "experiments")
+}
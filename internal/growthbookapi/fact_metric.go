@@ -0,0 +1,42 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// CreateFactMetric creates a new fact metric in GrowthBook.
+func (c *Client) CreateFactMetric(ctx context.Context, m *FactMetric) (*FactMetric, error) {
+	out, err := fetcher[FactMetric](c, "POST", "/fact-metrics").One(ctx, m, "factMetric")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFactMetric fetches a fact metric by its ID.
+func (c *Client) GetFactMetric(ctx context.Context, id string) (*FactMetric, error) {
+	out, err := fetcher[FactMetric](c, "GET", "/fact-metrics/"+id).One(ctx, nil, "factMetric")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateFactMetric updates an existing fact metric by its ID.
+func (c *Client) UpdateFactMetric(ctx context.Context, id string, m *FactMetric) (*FactMetric, error) {
+	out, err := fetcher[FactMetric](c, "POST", "/fact-metrics/"+id).One(ctx, m, "factMetric")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteFactMetric deletes a fact metric by its ID.
+func (c *Client) DeleteFactMetric(ctx context.Context, id string) error {
+	return c.delete(ctx, "/fact-metrics/"+id)
+}
+
+// ListFactMetrics fetches all fact metrics, handling pagination.
+func (c *Client) ListFactMetrics(ctx context.Context) ([]FactMetric, error) {
+	return fetcher[FactMetric](c, "GET", "/fact-metrics").All(ctx, nil, "factMetrics")
+}
@@ -0,0 +1,81 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// CreateFactTable creates a new fact table in GrowthBook.
+func (c *Client) CreateFactTable(ctx context.Context, t *FactTable) (*FactTable, error) {
+	out, err := fetcher[FactTable](c, "POST", "/fact-tables").One(ctx, t, "factTable")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFactTable fetches a fact table by its ID.
+func (c *Client) GetFactTable(ctx context.Context, id string) (*FactTable, error) {
+	out, err := fetcher[FactTable](c, "GET", "/fact-tables/"+id).One(ctx, nil, "factTable")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateFactTable updates an existing fact table by its ID.
+func (c *Client) UpdateFactTable(ctx context.Context, id string, t *FactTable) (*FactTable, error) {
+	out, err := fetcher[FactTable](c, "POST", "/fact-tables/"+id).One(ctx, t, "factTable")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteFactTable deletes a fact table by its ID.
+func (c *Client) DeleteFactTable(ctx context.Context, id string) error {
+	return c.delete(ctx, "/fact-tables/"+id)
+}
+
+// ListFactTables fetches all fact tables, handling pagination.
+func (c *Client) ListFactTables(ctx context.Context) ([]FactTable, error) {
+	return fetcher[FactTable](c, "GET", "/fact-tables").All(ctx, nil, "factTables")
+}
+
+// CreateFactTableFilter creates a new filter on a fact table.
+func (c *Client) CreateFactTableFilter(
+	ctx context.Context,
+	factTableID string,
+	f *FactTableFilter,
+) (*FactTableFilter, error) {
+	out, err := fetcher[FactTableFilter](c, "POST", "/fact-tables/"+factTableID+"/filters").
+		One(ctx, f, "factTableFilter")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateFactTableFilter updates an existing filter of a fact table.
+func (c *Client) UpdateFactTableFilter(
+	ctx context.Context,
+	factTableID, id string,
+	f *FactTableFilter,
+) (*FactTableFilter, error) {
+	out, err := fetcher[FactTableFilter](c, "POST", "/fact-tables/"+factTableID+"/filters/"+id).
+		One(ctx, f, "factTableFilter")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteFactTableFilter deletes a filter of a fact table.
+func (c *Client) DeleteFactTableFilter(ctx context.Context, factTableID, id string) error {
+	return c.delete(ctx, "/fact-tables/"+factTableID+"/filters/"+id)
+}
+
+// ListFactTableFilters fetches all filters of a fact table, handling pagination.
+func (c *Client) ListFactTableFilters(ctx context.Context, factTableID string) ([]FactTableFilter, error) {
+	return fetcher[FactTableFilter](c, "GET", "/fact-tables/"+factTableID+"/filters").
+		All(ctx, nil, "factTableFilters")
+}
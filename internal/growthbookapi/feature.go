@@ -2,6 +2,10 @@ package growthbookapi
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
 )
 
 // CreateFeature creates a new feature in GrowthBook.
@@ -48,16 +52,212 @@ func (c *Client) DeleteFeature(ctx context.Context, id string) error {
 	return c.delete(ctx, "/features/"+id)
 }
 
-// FindFeatureByName searches for a feature by its ID and returns the first match, handling pagination.
+// ListFeatures fetches all features, or those of filter.ProjectID, handling pagination.
+// Lists are cached until a feature is written.
+func (c *Client) ListFeatures(ctx context.Context, filter ListFilter) ([]Feature, error) {
+	path := "/features" + filter.query()
+	return cached(c, path, func() ([]Feature, error) {
+		return fetcher[Feature](c, "GET", path).All(ctx, nil, "features")
+	})
+}
+
+// FindFeatureByName fetches a feature by its ID, which is the name of the feature in GrowthBook.
 func (c *Client) FindFeatureByName(ctx context.Context, id string) (*Feature, error) {
-	features, err := fetcher[Feature](c, "GET", "/features").All(ctx, nil, "features")
+	return c.GetFeature(ctx, id)
+}
+
+// ToggleFeature enables or disables a feature in some environments with the dedicated toggle endpoint,
+// leaving the rules and other environments of the feature untouched.
+func (c *Client) ToggleFeature(ctx context.Context, id string, envs map[string]bool, reason string) (*Feature, error) {
+	body := map[string]any{"environments": envs}
+	if reason != "" {
+		body["reason"] = reason
+	}
+	out, err := fetcher[Feature](c, "POST", "/features/"+id+"/toggle").One(ctx, body, "feature")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// modifyFeatureEnvironments reads a feature, lets fn change its environments and writes them back.
+// The whole cycle holds the write lock of the feature, so concurrent edits of the feature from this provider are not lost.
+func (c *Client) modifyFeatureEnvironments(
+	ctx context.Context,
+	featureID string,
+	fn func(envs map[string]FeatureEnvironmentConfig) error,
+) (*Feature, error) {
+	ctx, unlock := c.lockWrite(ctx, "/features/"+featureID)
+	defer unlock()
+
+	f, err := c.GetFeature(ctx, featureID)
+	if err != nil {
+		return nil, err
+	}
+	envs := make(map[string]FeatureEnvironmentConfig, len(f.Environments)+1)
+	for name, e := range f.Environments {
+		envs[name] = e
+	}
+	if err := fn(envs); err != nil {
+		return nil, err
+	}
+	for name, e := range envs {
+		if e.Rules == nil {
+			e.Rules = []FeatureRule{}
+			envs[name] = e
+		}
+	}
+
+	body := map[string]any{"environments": envs}
+	out, err := fetcher[Feature](c, "POST", "/features/"+featureID).One(ctx, body, "feature")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateFeatureEnvironment replaces the configuration of one environment of a feature,
+// leaving its other environments untouched.
+func (c *Client) UpdateFeatureEnvironment(
+	ctx context.Context,
+	featureID, env string,
+	cfg FeatureEnvironmentConfig,
+) (*Feature, error) {
+	return c.modifyFeatureEnvironments(ctx, featureID, func(envs map[string]FeatureEnvironmentConfig) error {
+		envs[env] = cfg
+		return nil
+	})
+}
+
+// GetFeatureRule fetches a single rule of a feature environment by its ID.
+func (c *Client) GetFeatureRule(ctx context.Context, featureID, env, ruleID string) (*FeatureRule, error) {
+	f, err := c.GetFeature(ctx, featureID)
 	if err != nil {
 		return nil, err
 	}
-	for _, f := range features {
-		if f.ID == id {
-			return &f, nil
+	return f.Rule(env, ruleID)
+}
+
+// CreateFeatureRule inserts a rule in a feature environment, leaving the other rules untouched.
+// A rule ID is generated when the rule has none, so that the rule can be found again.
+func (c *Client) CreateFeatureRule(
+	ctx context.Context,
+	featureID, env string,
+	rule FeatureRule,
+	at RulePlacement,
+) (*FeatureRule, error) {
+	if rule.ID == "" {
+		id, err := newFeatureRuleID()
+		if err != nil {
+			return nil, err
+		}
+		rule.ID = id
+	}
+	f, err := c.modifyFeatureEnvironments(ctx, featureID, func(envs map[string]FeatureEnvironmentConfig) error {
+		cfg := envs[env]
+		rules, err := placeFeatureRule(cfg.Rules, rule, at)
+		if err != nil {
+			return err
 		}
+		cfg.Rules = rules
+		envs[env] = cfg
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.Rule(env, rule.ID)
+}
+
+// UpdateFeatureRule replaces a rule of a feature environment by its ID.
+// The rule keeps its position unless at is set, in which case it is moved.
+func (c *Client) UpdateFeatureRule(
+	ctx context.Context,
+	featureID, env string,
+	rule FeatureRule,
+	at *RulePlacement,
+) (*FeatureRule, error) {
+	f, err := c.modifyFeatureEnvironments(ctx, featureID, func(envs map[string]FeatureEnvironmentConfig) error {
+		cfg := envs[env]
+		i := featureRuleIndex(cfg.Rules, rule.ID)
+		if i < 0 {
+			return ErrNotFound
+		}
+		rules := slices.Clone(cfg.Rules)
+		if at == nil {
+			rules[i] = rule
+		} else {
+			var err error
+			rules, err = placeFeatureRule(slices.Delete(rules, i, i+1), rule, *at)
+			if err != nil {
+				return err
+			}
+		}
+		cfg.Rules = rules
+		envs[env] = cfg
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.Rule(env, rule.ID)
+}
+
+// DeleteFeatureRule removes a rule from a feature environment by its ID.
+func (c *Client) DeleteFeatureRule(ctx context.Context, featureID, env, ruleID string) error {
+	_, err := c.modifyFeatureEnvironments(ctx, featureID, func(envs map[string]FeatureEnvironmentConfig) error {
+		cfg := envs[env]
+		i := featureRuleIndex(cfg.Rules, ruleID)
+		if i < 0 {
+			return ErrNotFound
+		}
+		cfg.Rules = slices.Delete(slices.Clone(cfg.Rules), i, i+1)
+		envs[env] = cfg
+		return nil
+	})
+	return err
+}
+
+// Rule returns a rule of an environment of the feature by its ID.
+func (f *Feature) Rule(env, ruleID string) (*FeatureRule, error) {
+	rules := f.Environments[env].Rules
+	i := featureRuleIndex(rules, ruleID)
+	if i < 0 {
+		return nil, ErrNotFound
+	}
+	return &rules[i], nil
+}
+
+func featureRuleIndex(rules []FeatureRule, id string) int {
+	return slices.IndexFunc(rules, func(r FeatureRule) bool { return r.ID == id })
+}
+
+// placeFeatureRule inserts rule in rules as requested by at.
+func placeFeatureRule(rules []FeatureRule, rule FeatureRule, at RulePlacement) ([]FeatureRule, error) {
+	i := len(rules)
+	switch {
+	case at.BeforeID != "":
+		i = featureRuleIndex(rules, at.BeforeID)
+		if i < 0 {
+			return nil, fmt.Errorf("rule %s to insert before was not found", at.BeforeID)
+		}
+	case at.AfterID != "":
+		i = featureRuleIndex(rules, at.AfterID)
+		if i < 0 {
+			return nil, fmt.Errorf("rule %s to insert after was not found", at.AfterID)
+		}
+		i++
+	case at.Position == RulePositionFirst:
+		i = 0
+	}
+	return slices.Insert(slices.Clone(rules), i, rule), nil
+}
+
+// newFeatureRuleID generates a rule ID in the format used by GrowthBook.
+func newFeatureRuleID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	return nil, ErrNotFound
+	return "fr_" + hex.EncodeToString(b), nil
 }
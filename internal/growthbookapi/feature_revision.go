@@ -0,0 +1,75 @@
+package growthbookapi
+
+import (
+	"context"
+	"strconv"
+)
+
+// Feature revision statuses. Revisions in any other status than published or discarded are still open.
+const (
+	RevisionStatusDraft     = "draft"
+	RevisionStatusPublished = "published"
+	RevisionStatusDiscarded = "discarded"
+)
+
+// FeatureRevision is a version of the default value and environment rules of a feature.
+// Drafts go through the review workflow of the organization and change the live feature once published.
+type FeatureRevision struct {
+	Version       int                     `json:"version,omitempty"`
+	BaseVersion   int                     `json:"baseVersion,omitempty"`
+	Status        string                  `json:"status,omitempty"`
+	Comment       string                  `json:"comment,omitempty"`
+	DefaultValue  string                  `json:"defaultValue,omitempty"`
+	Environments  map[string]FeatureDraft `json:"environments,omitempty"`
+	DateCreated   string                  `json:"dateCreated,omitempty"`
+	DatePublished string                  `json:"datePublished,omitempty"`
+}
+
+// Open reports whether the revision can still be published or discarded.
+func (r *FeatureRevision) Open() bool {
+	return r.Status != RevisionStatusPublished && r.Status != RevisionStatusDiscarded
+}
+
+// CreateFeatureRevision creates a draft revision of a feature.
+func (c *Client) CreateFeatureRevision(ctx context.Context, featureID string, r *FeatureRevision) (*FeatureRevision, error) {
+	for env, d := range r.Environments {
+		if d.Rules == nil {
+			d.Rules = []FeatureRule{}
+			r.Environments[env] = d
+		}
+	}
+	out, err := fetcher[FeatureRevision](c, "POST", "/features/"+featureID+"/revisions").One(ctx, r, "revision")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListFeatureRevisions fetches all revisions of a feature.
+func (c *Client) ListFeatureRevisions(ctx context.Context, featureID string) ([]FeatureRevision, error) {
+	return fetcher[FeatureRevision](c, "GET", "/features/"+featureID+"/revisions").All(ctx, nil, "revisions")
+}
+
+// PublishFeatureRevision publishes a draft revision, making it the live version of the feature.
+func (c *Client) PublishFeatureRevision(ctx context.Context, featureID string, version int) (*FeatureRevision, error) {
+	out, err := fetcher[FeatureRevision](c, "POST", revisionPath(featureID, version)+"/publish").
+		One(ctx, map[string]any{}, "revision")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DiscardFeatureRevision discards a draft revision.
+func (c *Client) DiscardFeatureRevision(ctx context.Context, featureID string, version int) (*FeatureRevision, error) {
+	out, err := fetcher[FeatureRevision](c, "POST", revisionPath(featureID, version)+"/discard").
+		One(ctx, map[string]any{}, "revision")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func revisionPath(featureID string, version int) string {
+	return "/features/" + featureID + "/revisions/" + strconv.Itoa(version)
+}
@@ -0,0 +1,43 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// GetMember searches for a member of the organization by its user ID.
+// GrowthBook has no endpoint for a single member, so the cached member list is scanned.
+func (c *Client) GetMember(ctx context.Context, id string) (*Member, error) {
+	members, err := c.ListMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if m.ID == id {
+			return &m, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// ListMembers fetches all members of the organization, handling pagination. The list is cached until a member is
+// written.
+func (c *Client) ListMembers(ctx context.Context) ([]Member, error) {
+	return cached(c, "/members", func() ([]Member, error) {
+		return fetcher[Member](c, "GET", "/members").All(ctx, nil, "members")
+	})
+}
+
+// UpdateMemberRole replaces the global role, environment limits and project roles of a member.
+func (c *Client) UpdateMemberRole(ctx context.Context, id string, r *MemberRole) (*Member, error) {
+	if r.Environments == nil {
+		r.Environments = []string{}
+	}
+	if r.ProjectRoles == nil {
+		r.ProjectRoles = []ProjectRole{}
+	}
+	out, err := fetcher[Member](c, "POST", "/members/"+id+"/role").One(ctx, r, "member")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,71 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// MetricUpdateBody holds the fields of a legacy metric that can be changed after creation.
+type MetricUpdateBody struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Owner       string         `json:"owner,omitempty"`
+	Type        string         `json:"type"`
+	Tags        []string       `json:"tags"`
+	Projects    []string       `json:"projects"`
+	Archived    bool           `json:"archived"`
+	Behavior    MetricBehavior `json:"behavior"`
+	SQL         *MetricSQL     `json:"sql,omitempty"`
+}
+
+// CreateMetric creates a new legacy metric in GrowthBook.
+func (c *Client) CreateMetric(ctx context.Context, m *Metric) (*Metric, error) {
+	out, err := fetcher[Metric](c, "POST", "/metrics").One(ctx, m, "metric")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMetric fetches a legacy metric by its ID.
+func (c *Client) GetMetric(ctx context.Context, id string) (*Metric, error) {
+	out, err := fetcher[Metric](c, "GET", "/metrics/"+id).One(ctx, nil, "metric")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMetric updates an existing legacy metric by its ID.
+// The API answers with a status only, so the metric is fetched again afterwards.
+func (c *Client) UpdateMetric(ctx context.Context, id string, m *Metric) (*Metric, error) {
+	body := &MetricUpdateBody{
+		Name:        m.Name,
+		Description: m.Description,
+		Owner:       m.Owner,
+		Type:        m.Type,
+		Tags:        m.Tags,
+		Projects:    m.Projects,
+		Archived:    m.Archived,
+		Behavior:    m.Behavior,
+		SQL:         m.SQL,
+	}
+	resp, err := c.do(ctx, "PUT", "/metrics/"+id, body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := checkStatuses("PUT", resp); err != nil {
+		return nil, err
+	}
+	return c.GetMetric(ctx, id)
+}
+
+// DeleteMetric deletes a legacy metric by its ID.
+func (c *Client) DeleteMetric(ctx context.Context, id string) error {
+	return c.delete(ctx, "/metrics/"+id)
+}
+
+// ListMetrics fetches all legacy metrics, handling pagination.
+func (c *Client) ListMetrics(ctx context.Context) ([]Metric, error) {
+	return fetcher[Metric](c, "GET", "/metrics").All(ctx, nil, "metrics")
+}
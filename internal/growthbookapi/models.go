@@ -74,6 +74,20 @@ type FeaturePrerequisite struct {
 	Condition string `json:"condition"`
 }
 
+// RulePosition values for RulePlacement.
+const (
+	RulePositionFirst = "first"
+	RulePositionLast  = "last"
+)
+
+// RulePlacement tells where a rule goes among the rules of its environment.
+// BeforeID and AfterID place the rule next to an existing rule, otherwise Position is used (last by default).
+type RulePlacement struct {
+	Position string
+	BeforeID string
+	AfterID  string
+}
+
 // FeatureDraft represents a draft configuration for a feature.
 type FeatureDraft struct {
 	Enabled    bool          `json:"enabled"`
@@ -132,3 +146,339 @@ type SDKConnection struct {
 	DateCreated     string `json:"dateCreated,omitempty"`
 	DateUpdated     string `json:"dateUpdated,omitempty"`
 }
+
+// SavedGroup represents a GrowthBook saved group, either condition-based or a list of attribute values.
+type SavedGroup struct {
+	ID           string   `json:"id,omitempty"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Condition    string   `json:"condition,omitempty"`
+	AttributeKey string   `json:"attributeKey,omitempty"`
+	Values       []string `json:"values,omitempty"`
+	Owner        string   `json:"owner,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Projects     []string `json:"projects"`
+	DateCreated  string   `json:"dateCreated,omitempty"`
+	DateUpdated  string   `json:"dateUpdated,omitempty"`
+}
+
+// Experiment represents a GrowthBook experiment object as returned by the API.
+type Experiment struct {
+	ID            string                `json:"id,omitempty"`
+	TrackingKey   string                `json:"trackingKey"`
+	Name          string                `json:"name"`
+	Project       string                `json:"project,omitempty"`
+	Hypothesis    string                `json:"hypothesis,omitempty"`
+	Description   string                `json:"description,omitempty"`
+	Tags          []string              `json:"tags"`
+	Owner         string                `json:"owner,omitempty"`
+	Archived      bool                  `json:"archived"`
+	Status        string                `json:"status,omitempty"`
+	HashAttribute string                `json:"hashAttribute,omitempty"`
+	Variations    []ExperimentVariation `json:"variations"`
+	Phases        []ExperimentPhase     `json:"phases"`
+	Settings      ExperimentSettings    `json:"settings"`
+	DateCreated   string                `json:"dateCreated,omitempty"`
+	DateUpdated   string                `json:"dateUpdated,omitempty"`
+}
+
+// ExperimentVariation represents a single variation of an experiment.
+type ExperimentVariation struct {
+	VariationID string `json:"variationId"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// ExperimentPhase represents a phase of an experiment, with its coverage and traffic split.
+type ExperimentPhase struct {
+	Name               string                   `json:"name"`
+	DateStarted        string                   `json:"dateStarted,omitempty"`
+	DateEnded          string                   `json:"dateEnded,omitempty"`
+	ReasonForStopping  string                   `json:"reasonForStopping,omitempty"`
+	Coverage           float64                  `json:"coverage"`
+	TrafficSplit       []ExperimentTrafficSplit `json:"trafficSplit"`
+	TargetingCondition string                   `json:"targetingCondition,omitempty"`
+}
+
+// ExperimentTrafficSplit holds the weight assigned to a variation during a phase.
+type ExperimentTrafficSplit struct {
+	VariationID string  `json:"variationId"`
+	Weight      float64 `json:"weight"`
+}
+
+// ExperimentSettings holds the analysis settings of an experiment.
+type ExperimentSettings struct {
+	DatasourceID      string             `json:"datasourceId,omitempty"`
+	AssignmentQueryID string             `json:"assignmentQueryId,omitempty"`
+	Goals             []ExperimentMetric `json:"goals"`
+	SecondaryMetrics  []ExperimentMetric `json:"secondaryMetrics"`
+	Guardrails        []ExperimentMetric `json:"guardrails"`
+}
+
+// ExperimentMetric references a metric used in an experiment analysis.
+type ExperimentMetric struct {
+	MetricID string `json:"metricId"`
+}
+
+// Metric represents a legacy GrowthBook metric, defined by SQL against a data source.
+type Metric struct {
+	ID           string         `json:"id,omitempty"`
+	DatasourceID string         `json:"datasourceId,omitempty"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description,omitempty"`
+	Owner        string         `json:"owner,omitempty"`
+	Type         string         `json:"type"`
+	Tags         []string       `json:"tags"`
+	Projects     []string       `json:"projects"`
+	Archived     bool           `json:"archived"`
+	Behavior     MetricBehavior `json:"behavior"`
+	SQL          *MetricSQL     `json:"sql,omitempty"`
+	DateCreated  string         `json:"dateCreated,omitempty"`
+	DateUpdated  string         `json:"dateUpdated,omitempty"`
+}
+
+// MetricBehavior holds how a legacy metric is interpreted in experiment analyses.
+type MetricBehavior struct {
+	Goal            string                 `json:"goal,omitempty"`
+	CappingSettings *MetricCappingSettings `json:"cappingSettings,omitempty"`
+	WindowSettings  *MetricWindowSettings  `json:"windowSettings,omitempty"`
+}
+
+// MetricSQL holds the SQL definition of a legacy metric.
+type MetricSQL struct {
+	IdentifierTypes     []string `json:"identifierTypes"`
+	ConversionSQL       string   `json:"conversionSQL"`
+	UserAggregationSQL  string   `json:"userAggregationSQL,omitempty"`
+	DenominatorMetricID string   `json:"denominatorMetricId,omitempty"`
+}
+
+// MetricCappingSettings holds the capping applied to metric values.
+type MetricCappingSettings struct {
+	Type        string  `json:"type"`
+	Value       float64 `json:"value"`
+	IgnoreZeros bool    `json:"ignoreZeros"`
+}
+
+// MetricWindowSettings holds the conversion or lookback window of a metric.
+type MetricWindowSettings struct {
+	Type        string  `json:"type"`
+	DelayHours  float64 `json:"delayHours"`
+	WindowValue float64 `json:"windowValue"`
+	WindowUnit  string  `json:"windowUnit,omitempty"`
+}
+
+// FactMetric represents a GrowthBook metric defined on top of fact tables.
+type FactMetric struct {
+	ID              string                 `json:"id,omitempty"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description,omitempty"`
+	Owner           string                 `json:"owner,omitempty"`
+	Projects        []string               `json:"projects"`
+	Tags            []string               `json:"tags"`
+	Datasource      string                 `json:"datasource,omitempty"`
+	MetricType      string                 `json:"metricType"`
+	Numerator       FactMetricColumn       `json:"numerator"`
+	Denominator     *FactMetricColumn      `json:"denominator,omitempty"`
+	Inverse         bool                   `json:"inverse"`
+	CappingSettings *MetricCappingSettings `json:"cappingSettings,omitempty"`
+	WindowSettings  *MetricWindowSettings  `json:"windowSettings,omitempty"`
+	DateCreated     string                 `json:"dateCreated,omitempty"`
+	DateUpdated     string                 `json:"dateUpdated,omitempty"`
+}
+
+// FactMetricColumn references a fact table column used as a fact metric numerator or denominator.
+type FactMetricColumn struct {
+	FactTableID string   `json:"factTableId"`
+	Column      string   `json:"column"`
+	Aggregation string   `json:"aggregation,omitempty"`
+	Filters     []string `json:"filters"`
+}
+
+// DataSource represents a GrowthBook data source, the connection to a data warehouse or analytics tool.
+// Connection params are never returned by the API.
+type DataSource struct {
+	ID                string                      `json:"id,omitempty"`
+	Name              string                      `json:"name"`
+	Type              string                      `json:"type"`
+	Description       string                      `json:"description,omitempty"`
+	ProjectIDs        []string                    `json:"projectIds"`
+	EventTracker      string                      `json:"eventTracker,omitempty"`
+	IdentifierTypes   []DataSourceIdentifierType  `json:"identifierTypes"`
+	AssignmentQueries []DataSourceAssignmentQuery `json:"assignmentQueries"`
+	DateCreated       string                      `json:"dateCreated,omitempty"`
+	DateUpdated       string                      `json:"dateUpdated,omitempty"`
+}
+
+// DataSourceIdentifierType is a unit of randomization (user_id, anonymous_id, ...) exposed by a data source.
+type DataSourceIdentifierType struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+}
+
+// DataSourceAssignmentQuery is an experiment exposure query of a data source.
+type DataSourceAssignmentQuery struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description,omitempty"`
+	IdentifierType      string   `json:"identifierType"`
+	SQL                 string   `json:"sql"`
+	IncludesNameColumns bool     `json:"includesNameColumns"`
+	DimensionColumns    []string `json:"dimensionColumns"`
+}
+
+// FactTable represents a GrowthBook fact table, a SQL query over the events of a data source.
+type FactTable struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Owner       string            `json:"owner,omitempty"`
+	Projects    []string          `json:"projects"`
+	Tags        []string          `json:"tags"`
+	Datasource  string            `json:"datasource"`
+	UserIDTypes []string          `json:"userIdTypes"`
+	SQL         string            `json:"sql"`
+	EventName   string            `json:"eventName,omitempty"`
+	Columns     []FactTableColumn `json:"columns,omitempty"`
+	DateCreated string            `json:"dateCreated,omitempty"`
+	DateUpdated string            `json:"dateUpdated,omitempty"`
+}
+
+// FactTableColumn describes a column returned by the SQL of a fact table.
+type FactTableColumn struct {
+	Column       string `json:"column"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Datatype     string `json:"datatype,omitempty"`
+	NumberFormat string `json:"numberFormat,omitempty"`
+	Deleted      bool   `json:"deleted,omitempty"`
+}
+
+// FactTableFilter is a reusable SQL condition defined on a fact table.
+type FactTableFilter struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value"`
+	DateCreated string `json:"dateCreated,omitempty"`
+	DateUpdated string `json:"dateUpdated,omitempty"`
+}
+
+// APIKey represents a GrowthBook API key. Secret keys act with their own Role, personal keys act as the user who
+// created them. GrowthBook only returns Key when the API key is created.
+type APIKey struct {
+	ID          string `json:"id,omitempty"`
+	Description string `json:"description"`
+	Secret      bool   `json:"secret"`
+	Role        string `json:"role,omitempty"`
+	Key         string `json:"key,omitempty"`
+	UserID      string `json:"userId,omitempty"`
+	DateCreated string `json:"dateCreated,omitempty"`
+}
+
+// ProjectRole is the role of a member or team in one project, overriding their global role there.
+type ProjectRole struct {
+	Project                  string   `json:"project"`
+	Role                     string   `json:"role"`
+	LimitAccessByEnvironment bool     `json:"limitAccessByEnvironment"`
+	Environments             []string `json:"environments"`
+}
+
+// Member represents a user of the GrowthBook organization.
+type Member struct {
+	ID                       string        `json:"id"`
+	Name                     string        `json:"name,omitempty"`
+	Email                    string        `json:"email"`
+	Role                     string        `json:"role"`
+	LimitAccessByEnvironment bool          `json:"limitAccessByEnvironment"`
+	Environments             []string      `json:"environments"`
+	ProjectRoles             []ProjectRole `json:"projectRoles"`
+	Teams                    []string      `json:"teams,omitempty"`
+	DateCreated              string        `json:"dateCreated,omitempty"`
+}
+
+// MemberRole holds the access of a member, the fields of a Member that UpdateMemberRole changes.
+type MemberRole struct {
+	Role                     string        `json:"role"`
+	LimitAccessByEnvironment bool          `json:"limitAccessByEnvironment"`
+	Environments             []string      `json:"environments"`
+	ProjectRoles             []ProjectRole `json:"projectRoles"`
+}
+
+// Team represents a GrowthBook team, whose members get the roles of the team on top of their own.
+type Team struct {
+	ID                       string        `json:"id,omitempty"`
+	Name                     string        `json:"name"`
+	Description              string        `json:"description,omitempty"`
+	Role                     string        `json:"role"`
+	LimitAccessByEnvironment bool          `json:"limitAccessByEnvironment"`
+	Environments             []string      `json:"environments"`
+	ProjectRoles             []ProjectRole `json:"projectRoles"`
+	Members                  []string      `json:"members"`
+	ManagedByIdp             bool          `json:"managedByIdp,omitempty"`
+	DateCreated              string        `json:"dateCreated,omitempty"`
+	DateUpdated              string        `json:"dateUpdated,omitempty"`
+}
+
+// Role represents a custom GrowthBook role, a set of policies identified by a name chosen on creation.
+type Role struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description,omitempty"`
+	Policies    []string `json:"policies"`
+}
+
+// OrganizationSettings holds the organization-wide settings. The fields are pointers so that updates only send the
+// settings that are set, GrowthBook keeps the others.
+type OrganizationSettings struct {
+	StatsEngine           *string          `json:"statsEngine,omitempty"`
+	ConfidenceLevel       *float64         `json:"confidenceLevel,omitempty"`
+	RequireReviews        *[]RequireReview `json:"requireReviews,omitempty"`
+	UseStickyBucketing    *bool            `json:"useStickyBucketing,omitempty"`
+	UseFallbackAttributes *bool            `json:"useFallbackAttributes,omitempty"`
+	SecureAttributeSalt   *string          `json:"secureAttributeSalt,omitempty"`
+	FeatureKeyExample     *string          `json:"featureKeyExample,omitempty"`
+	FeatureRegexValidator *string          `json:"featureRegexValidator,omitempty"`
+}
+
+// RequireReview decides whether feature changes in some projects and environments need an approved review.
+// Empty Projects or Environments match all of them.
+type RequireReview struct {
+	RequireReviewOn     bool     `json:"requireReviewOn"`
+	ResetReviewOnChange bool     `json:"resetReviewOnChange"`
+	Environments        []string `json:"environments"`
+	Projects            []string `json:"projects"`
+}
+
+// SDKWebhook is called whenever the payload of its SDK connections changes, e.g. to purge a CDN cache.
+type SDKWebhook struct {
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name"`
+	Endpoint      string   `json:"endpoint"`
+	HTTPMethod    string   `json:"httpMethod,omitempty"`
+	Headers       string   `json:"headers"` // a serialized JSON object
+	PayloadFormat string   `json:"payloadFormat,omitempty"`
+	SendPayload   bool     `json:"sendPayload"`
+	SDKs          []string `json:"sdks,omitempty"`
+	SigningKey    string   `json:"signingKey,omitempty"`
+	DateCreated   string   `json:"dateCreated,omitempty"`
+	DateUpdated   string   `json:"dateUpdated,omitempty"`
+}
+
+// EventWebhook is called on the GrowthBook events it subscribes to, e.g. "feature.updated", optionally filtered by
+// project, environment and tag.
+type EventWebhook struct {
+	ID           string            `json:"id,omitempty"`
+	Name         string            `json:"name"`
+	URL          string            `json:"url"`
+	Events       []string          `json:"events"`
+	Enabled      bool              `json:"enabled"`
+	Projects     []string          `json:"projects"`
+	Environments []string          `json:"environments"`
+	Tags         []string          `json:"tags"`
+	PayloadType  string            `json:"payloadType,omitempty"`
+	Method       string            `json:"method,omitempty"`
+	Headers      map[string]string `json:"headers"`
+	SigningKey   string            `json:"signingKey,omitempty"`
+	DateCreated  string            `json:"dateCreated,omitempty"`
+	DateUpdated  string            `json:"dateUpdated,omitempty"`
+}
@@ -38,9 +38,17 @@ func (c *Client) DeleteProject(ctx context.Context, id string) error {
 	return c.delete(ctx, "/projects/"+id)
 }
 
-// FindProjectByName searches for a project by its name and returns the first match, handling pagination.
+// ListProjects fetches all projects, handling pagination. The list is cached until a project is written.
+func (c *Client) ListProjects(ctx context.Context) ([]Project, error) {
+	return cached(c, "/projects", func() ([]Project, error) {
+		return fetcher[Project](c, "GET", "/projects").All(ctx, nil, "projects")
+	})
+}
+
+// FindProjectByName searches for a project by its name and returns the first match.
+// GrowthBook cannot filter projects by name, so the cached project list is scanned.
 func (c *Client) FindProjectByName(ctx context.Context, name string) (*Project, error) {
-	projects, err := fetcher[Project](c, "GET", "/projects").All(ctx, nil, "projects")
+	projects, err := c.ListProjects(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,37 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// CreateRole creates a new custom role in GrowthBook, identified by r.ID.
+func (c *Client) CreateRole(ctx context.Context, r *Role) (*Role, error) {
+	out, err := fetcher[Role](c, "POST", "/roles").One(ctx, r, "role")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRole fetches a custom role by its ID.
+func (c *Client) GetRole(ctx context.Context, id string) (*Role, error) {
+	out, err := fetcher[Role](c, "GET", "/roles/"+id).One(ctx, nil, "role")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateRole updates the description and policies of a custom role.
+func (c *Client) UpdateRole(ctx context.Context, id string, r *Role) (*Role, error) {
+	out, err := fetcher[Role](c, "PUT", "/roles/"+id).One(ctx, r, "role")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRole deletes a custom role by its ID. GrowthBook refuses to delete roles still assigned.
+func (c *Client) DeleteRole(ctx context.Context, id string) error {
+	return c.delete(ctx, "/roles/"+id)
+}
@@ -0,0 +1,58 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// SavedGroupUpdateBody holds the fields of a saved group that can be changed after creation. The fields are pointers
+// so that updates only send the fields that are set, GrowthBook keeps the others. Set fields are sent even when empty,
+// so that they can be cleared.
+type SavedGroupUpdateBody struct {
+	Name        string    `json:"name"`
+	Condition   *string   `json:"condition,omitempty"`
+	Values      *[]string `json:"values,omitempty"`
+	Owner       *string   `json:"owner,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Projects    *[]string `json:"projects,omitempty"`
+}
+
+// CreateSavedGroup creates a new saved group in GrowthBook.
+func (c *Client) CreateSavedGroup(ctx context.Context, g *SavedGroup) (*SavedGroup, error) {
+	if g.Projects == nil {
+		g.Projects = []string{}
+	}
+	out, err := fetcher[SavedGroup](c, "POST", "/saved-groups").One(ctx, g, "savedGroup")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSavedGroup fetches a saved group by its ID.
+func (c *Client) GetSavedGroup(ctx context.Context, id string) (*SavedGroup, error) {
+	out, err := fetcher[SavedGroup](c, "GET", "/saved-groups/"+id).One(ctx, nil, "savedGroup")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSavedGroup updates an existing saved group by its ID.
+// The type and attribute key of a saved group cannot be changed once created.
+func (c *Client) UpdateSavedGroup(ctx context.Context, id string, body *SavedGroupUpdateBody) (*SavedGroup, error) {
+	out, err := fetcher[SavedGroup](c, "POST", "/saved-groups/"+id).One(ctx, body, "savedGroup")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSavedGroup deletes a saved group by its ID.
+func (c *Client) DeleteSavedGroup(ctx context.Context, id string) error {
+	return c.delete(ctx, "/saved-groups/"+id)
+}
+
+// ListSavedGroups fetches all saved groups, handling pagination.
+func (c *Client) ListSavedGroups(ctx context.Context) ([]SavedGroup, error) {
+	return fetcher[SavedGroup](c, "GET", "/saved-groups").All(ctx, nil, "savedGroups")
+}
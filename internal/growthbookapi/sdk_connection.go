@@ -47,17 +47,30 @@ func (c *Client) DeleteSDKConnection(ctx context.Context, id string) error {
 	return c.delete(ctx, "/sdk-connections/"+id)
 }
 
-// FindSDKConnectionByName searches for an SDK connection by its name and returns the first match, handling pagination.
+// ListSDKConnections fetches all SDK connections, or those of filter.ProjectID, handling pagination.
+// Lists are cached until an SDK connection is written.
+func (c *Client) ListSDKConnections(ctx context.Context, filter ListFilter) ([]SDKConnection, error) {
+	path := "/sdk-connections" + filter.query()
+	return cached(c, path, func() ([]SDKConnection, error) {
+		sdks, err := fetcher[SDKConnection](c, "GET", path).All(ctx, nil, "connections")
+		for i := range sdks {
+			if len(sdks[i].Languages) != 0 {
+				sdks[i].Language = sdks[i].Languages[0]
+			}
+		}
+		return sdks, err
+	})
+}
+
+// FindSDKConnectionByName searches for an SDK connection by its name and returns the first match.
+// GrowthBook cannot filter SDK connections by name, so the cached SDK connection list is scanned.
 func (c *Client) FindSDKConnectionByName(ctx context.Context, name string) (*SDKConnection, error) {
-	sdks, err := fetcher[SDKConnection](c, "GET", "/sdk-connections").All(ctx, nil, "connections")
+	sdks, err := c.ListSDKConnections(ctx, ListFilter{})
 	if err != nil {
 		return nil, err
 	}
 	for _, s := range sdks {
 		if s.Name == name {
-			if len(s.Languages) != 0 {
-				s.Language = s.Languages[0]
-			}
 			return &s, nil
 		}
 	}
@@ -0,0 +1,40 @@
+package growthbookapi
+
+import "context"
+
+// CreateSDKWebhook creates a webhook called when the payload of an SDK connection changes.
+func (c *Client) CreateSDKWebhook(ctx context.Context, sdkConnectionID string, w *SDKWebhook) (*SDKWebhook, error) {
+	out, err := fetcher[SDKWebhook](c, "POST", "/sdk-connections/"+sdkConnectionID+"/webhooks").One(ctx, w, "webhook")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSDKWebhook fetches an SDK webhook by its ID.
+func (c *Client) GetSDKWebhook(ctx context.Context, id string) (*SDKWebhook, error) {
+	out, err := fetcher[SDKWebhook](c, "GET", "/sdk-webhooks/"+id).One(ctx, nil, "webhook")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSDKWebhook updates an existing SDK webhook by its ID.
+func (c *Client) UpdateSDKWebhook(ctx context.Context, id string, w *SDKWebhook) (*SDKWebhook, error) {
+	out, err := fetcher[SDKWebhook](c, "PUT", "/sdk-webhooks/"+id).One(ctx, w, "webhook")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSDKWebhook deletes an SDK webhook by its ID.
+func (c *Client) DeleteSDKWebhook(ctx context.Context, id string) error {
+	return c.delete(ctx, "/sdk-webhooks/"+id)
+}
+
+// ListSDKWebhooks fetches the webhooks of an SDK connection.
+func (c *Client) ListSDKWebhooks(ctx context.Context, sdkConnectionID string) ([]SDKWebhook, error) {
+	return fetcher[[]SDKWebhook](c, "GET", "/sdk-connections/"+sdkConnectionID+"/webhooks").One(ctx, nil, "webhooks")
+}
@@ -0,0 +1,21 @@
+package growthbookapi
+
+import "context"
+
+// GetOrganizationSettings fetches the settings of the organization.
+func (c *Client) GetOrganizationSettings(ctx context.Context) (*OrganizationSettings, error) {
+	out, err := fetcher[OrganizationSettings](c, "GET", "/settings").One(ctx, nil, "settings")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateOrganizationSettings changes the settings set in s and returns all the settings of the organization.
+func (c *Client) UpdateOrganizationSettings(ctx context.Context, s *OrganizationSettings) (*OrganizationSettings, error) {
+	out, err := fetcher[OrganizationSettings](c, "PUT", "/settings").One(ctx, s, "settings")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,57 @@
+package growthbookapi
+
+import (
+	"context"
+)
+
+// CreateTeam creates a new team in GrowthBook.
+func (c *Client) CreateTeam(ctx context.Context, t *Team) (*Team, error) {
+	out, err := fetcher[Team](c, "POST", "/teams").One(ctx, teamBody(t), "team")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTeam fetches a team by its ID.
+func (c *Client) GetTeam(ctx context.Context, id string) (*Team, error) {
+	out, err := fetcher[Team](c, "GET", "/teams/"+id).One(ctx, nil, "team")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTeam updates an existing team by its ID, including its members.
+func (c *Client) UpdateTeam(ctx context.Context, id string, t *Team) (*Team, error) {
+	out, err := fetcher[Team](c, "PUT", "/teams/"+id).One(ctx, teamBody(t), "team")
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTeam deletes a team by its ID.
+func (c *Client) DeleteTeam(ctx context.Context, id string) error {
+	return c.delete(ctx, "/teams/"+id)
+}
+
+// ListTeams fetches all teams, handling pagination.
+func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
+	return fetcher[Team](c, "GET", "/teams").All(ctx, nil, "teams")
+}
+
+// teamBody sends empty lists rather than null ones, which GrowthBook would keep unchanged.
+func teamBody(t *Team) *Team {
+	body := *t
+	if body.Environments == nil {
+		body.Environments = []string{}
+	}
+	if body.ProjectRoles == nil {
+		body.ProjectRoles = []ProjectRole{}
+	}
+	if body.Members == nil {
+		body.Members = []string{}
+	}
+	return &body
+}
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+// providerClients is the data the provider hands to resources, data sources and ephemeral resources: the client of
+// the provider api_key and one client per entry of the provider organizations. Resources pick theirs per request,
+// from their organization attribute.
+type providerClients struct {
+	// defaultClient is nil when the provider only configures organizations.
+	defaultClient *growthbookapi.Client
+	organizations map[string]*growthbookapi.Client
+	// omitSDKConnectionSecrets keeps the keys of the growthbook_sdk_connection resource and data source out of the state.
+	omitSDKConnectionSecrets bool
+	// omitAPIKeySecrets keeps the keys of growthbook_api_key out of the state.
+	omitAPIKeySecrets bool
+}
+
+// client returns the client of an organization, or the default client when organization is null.
+func (p *providerClients) client(organization types.String) (*growthbookapi.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if organization.IsNull() || organization.IsUnknown() {
+		if p.defaultClient == nil {
+			diags.AddAttributeError(path.Root("organization"), "Missing organization",
+				"The provider has no 'api_key', so the organization must be one of: "+p.names()+".")
+		}
+		return p.defaultClient, diags
+	}
+	client, ok := p.organizations[organization.ValueString()]
+	if !ok {
+		diags.AddAttributeError(path.Root("organization"), "Unknown organization",
+			fmt.Sprintf("Organization %q is not in the provider 'organizations': %s.", organization.ValueString(), p.names()))
+	}
+	return client, diags
+}
+
+func (p *providerClients) names() string {
+	names := make([]string, 0, len(p.organizations))
+	for name := range p.organizations {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// cutImportOrganization splits import IDs ending with `@<organization>`. The suffix is only considered when it names
+// a configured organization, so that names containing @ can still be imported from the default organization.
+func (p *providerClients) cutImportOrganization(id string) (string, types.String) {
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return id, types.StringNull()
+	}
+	if _, ok := p.organizations[id[i+1:]]; !ok {
+		return id, types.StringNull()
+	}
+	return id[:i], types.StringValue(id[i+1:])
+}
+
+// importOrganization strips the organization from an import ID, or reads it from the identity when importing by
+// identity, and stores it in the state.
+func (p *providerClients) importOrganization(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) (resource.ImportStateRequest, types.String) {
+	var organization types.String
+	if req.ID == "" && req.Identity != nil {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	} else {
+		req.ID, organization = p.cutImportOrganization(req.ID)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	return req, organization
+}
+
+// organizationResourceAttribute is the organization attribute of every resource.
+func organizationResourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: "The organization of the resource, a key of the provider 'organizations'. " +
+			"Defaults to the organization of the provider 'api_key'.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// organizationIdentityAttribute is the organization attribute of every resource identity.
+func organizationIdentityAttribute() identityschema.StringAttribute {
+	return identityschema.StringAttribute{
+		OptionalForImport: true,
+		Description: "The organization of the resource, a key of the provider 'organizations'. " +
+			"Null for the organization of the provider 'api_key'.",
+	}
+}
+
+// organizationDataSourceAttribute is the organization attribute of every data source.
+func organizationDataSourceAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional: true,
+		Description: "The organization to read from, a key of the provider 'organizations'. " +
+			"Defaults to the organization of the provider 'api_key'.",
+	}
+}
+
+// organizationEphemeralAttribute is the organization attribute of every ephemeral resource.
+func organizationEphemeralAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Optional: true,
+		Description: "The organization to read from, a key of the provider 'organizations'. " +
+			"Defaults to the organization of the provider 'api_key'.",
+	}
+}
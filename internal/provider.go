@@ -9,15 +9,20 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-growthbook/internal/growthbookapi"
 )
 
 var _ provider.Provider = &growthbookProvider{}
+var _ provider.ProviderWithFunctions = &growthbookProvider{}
+var _ provider.ProviderWithEphemeralResources = &growthbookProvider{}
 
 // New returns a new GrowthBook provider.
 func New() provider.Provider {
@@ -27,14 +32,24 @@ func New() provider.Provider {
 type growthbookProvider struct{}
 
 type growthbookProviderModel struct {
-	APIKey             types.String `tfsdk:"api_key"`
-	APIURL             types.String `tfsdk:"api_url"`
-	HTTPTimeout        types.Int64  `tfsdk:"http_timeout"`
-	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
-	RetryMaxAttempts   types.Int64  `tfsdk:"retry_max_attempts"`
-	RetryMinBackoffMs  types.Int64  `tfsdk:"retry_min_backoff_ms"`
-	RetryMaxBackoffMs  types.Int64  `tfsdk:"retry_max_backoff_ms"`
-	QueryLimit         types.Int64  `tfsdk:"query_limit"`
+	APIKey             types.String                 `tfsdk:"api_key"`
+	APIURL             types.String                 `tfsdk:"api_url"`
+	HTTPTimeout        types.Int64                  `tfsdk:"http_timeout"`
+	InsecureSkipVerify types.Bool                   `tfsdk:"insecure_skip_verify"`
+	RetryMaxAttempts   types.Int64                  `tfsdk:"retry_max_attempts"`
+	RetryMinBackoffMs  types.Int64                  `tfsdk:"retry_min_backoff_ms"`
+	RetryMaxBackoffMs  types.Int64                  `tfsdk:"retry_max_backoff_ms"`
+	QueryLimit         types.Int64                  `tfsdk:"query_limit"`
+	PublishMode        types.String                 `tfsdk:"publish_mode"`
+	WriteLockScope     types.String                 `tfsdk:"write_lock_scope"`
+	OmitSDKSecrets     types.Bool                   `tfsdk:"omit_sdk_connection_secrets"`
+	OmitAPIKeySecrets  types.Bool                   `tfsdk:"omit_api_key_secrets"`
+	Organizations      map[string]organizationModel `tfsdk:"organizations"`
+}
+
+type organizationModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+	APIURL types.String `tfsdk:"api_url"`
 }
 
 func (p *growthbookProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -78,6 +93,55 @@ func (p *growthbookProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Description: "Maximum number of items to fetch per page for paginated API requests.",
 			},
+			"publish_mode": schema.StringAttribute{
+				Optional: true,
+				Description: "How feature default values and rules are changed: 'live' (the default) writes them " +
+					"directly, 'draft' stages them as a draft revision awaiting review. Can be overridden per feature. " +
+					"growthbook_feature_environment, growthbook_feature_rule and growthbook_feature_toggle always write " +
+					"live and are rejected with 'draft'.",
+				Validators: []validator.String{
+					stringOneOf(growthbookapi.PublishModeLive, growthbookapi.PublishModeDraft),
+				},
+			},
+			"write_lock_scope": schema.StringAttribute{
+				Optional: true,
+				Description: "Which API writes are serialized: 'object' (the default) only serializes writes to the " +
+					"same object and to attributes and environments, which GrowthBook stores as a single array, " +
+					"'collection' serializes writes to the same type of object, 'global' serializes all writes. " +
+					"Writes to different organizations are never serialized.",
+				Validators: []validator.String{
+					stringOneOf(growthbookapi.WriteLockObject, growthbookapi.WriteLockCollection, growthbookapi.WriteLockGlobal),
+				},
+			},
+			"omit_sdk_connection_secrets": schema.BoolAttribute{
+				Optional: true,
+				Description: "If true, the growthbook_sdk_connection resource and data source do not store the " +
+					"'key', 'encryption_key' and 'proxy_signing_key' in the state. Read them with the " +
+					"growthbook_sdk_connection_secrets ephemeral resource instead.",
+			},
+			"omit_api_key_secrets": schema.BoolAttribute{
+				Optional: true,
+				Description: "If true, growthbook_api_key does not store the 'key' in the state. GrowthBook only " +
+					"returns keys on creation, so they are then only revealed in the GrowthBook UI.",
+			},
+			"organizations": schema.MapNestedAttribute{
+				Optional: true,
+				Description: "Additional GrowthBook organizations, by name. Resources and data sources use one with their " +
+					"'organization' attribute. The other provider settings apply to every organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_key": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "The GrowthBook API key of the organization.",
+						},
+						"api_url": schema.StringAttribute{
+							Optional:    true,
+							Description: "The GrowthBook API base URL of the organization. Defaults to the provider 'api_url'.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -93,10 +157,11 @@ func (p *growthbookProvider) Configure(ctx context.Context, req provider.Configu
 	if apiKey == "" {
 		apiKey = os.Getenv("GROWTHBOOK_API_KEY")
 	}
-	if apiKey == "" {
+	if apiKey == "" && len(config.Organizations) == 0 {
 		resp.Diagnostics.AddError(
 			"Missing GrowthBook API key",
-			"The 'api_key' property must be set in the provider configuration or via the GROWTHBOOK_API_KEY environment variable.",
+			"The 'api_key' property must be set in the provider configuration or via the GROWTHBOOK_API_KEY environment "+
+				"variable, unless 'organizations' are configured.",
 		)
 		return
 	}
@@ -139,6 +204,25 @@ func (p *growthbookProvider) Configure(ctx context.Context, req provider.Configu
 		queryLimit = config.QueryLimit.ValueInt64()
 	}
 
+	publishMode := growthbookapi.PublishModeLive
+	if !config.PublishMode.IsNull() && !config.PublishMode.IsUnknown() {
+		publishMode = config.PublishMode.ValueString()
+	}
+
+	writeLockScope := growthbookapi.WriteLockObject
+	if !config.WriteLockScope.IsNull() && !config.WriteLockScope.IsUnknown() {
+		writeLockScope = config.WriteLockScope.ValueString()
+	}
+
+	omitSDKSecrets := false
+	if !config.OmitSDKSecrets.IsNull() && !config.OmitSDKSecrets.IsUnknown() {
+		omitSDKSecrets = config.OmitSDKSecrets.ValueBool()
+	}
+	omitAPIKeySecrets := false
+	if !config.OmitAPIKeySecrets.IsNull() && !config.OmitAPIKeySecrets.IsUnknown() {
+		omitAPIKeySecrets = config.OmitAPIKeySecrets.ValueBool()
+	}
+
 	transport := &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure}, //nolint:gosec
 	}
@@ -147,9 +231,7 @@ func (p *growthbookProvider) Configure(ctx context.Context, req provider.Configu
 		Transport: transport,
 	}
 
-	client := growthbookapi.NewClient(
-		apiURL,
-		apiKey,
+	opts := []growthbookapi.Option{
 		growthbookapi.WithHTTPClient(httpClient),
 		growthbookapi.WithBackoff(growthbookapi.BackoffConfig{
 			MaxRetries:      int(retryMaxAttempts),
@@ -158,29 +240,86 @@ func (p *growthbookProvider) Configure(ctx context.Context, req provider.Configu
 			MaxInterval:     time.Duration(retryMaxBackoff) * time.Millisecond,
 		}),
 		growthbookapi.WithPageLimit(int(queryLimit)),
-	)
+		growthbookapi.WithPublishMode(publishMode),
+		growthbookapi.WithWriteLockScope(writeLockScope),
+	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	// every organization gets its own client, hence its own response cache
+	clients := &providerClients{
+		organizations:            make(map[string]*growthbookapi.Client, len(config.Organizations)),
+		omitSDKConnectionSecrets: omitSDKSecrets,
+		omitAPIKeySecrets:        omitAPIKeySecrets,
+	}
+	if apiKey != "" {
+		clients.defaultClient, _ = growthbookapi.NewClient(apiURL, apiKey, opts...).(*growthbookapi.Client)
+	}
+	for name, org := range config.Organizations {
+		orgURL := apiURL
+		if !org.APIURL.IsNull() && !org.APIURL.IsUnknown() && org.APIURL.ValueString() != "" {
+			orgURL = org.APIURL.ValueString()
+		}
+		clients.organizations[name], _ = growthbookapi.NewClient(orgURL, org.APIKey.ValueString(), opts...).(*growthbookapi.Client)
+	}
+
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
+	resp.EphemeralResourceData = clients
 }
 
 func (p *growthbookProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newProjectResource,
 		newFeatureResource,
+		newFeatureEnvironmentResource,
+		newFeatureRuleResource,
+		newFeatureToggleResource,
 		newEnvironmentResource,
 		newSDKConnectionResource,
 		newAttributeResource,
+		newSavedGroupResource,
+		newExperimentResource,
+		newMetricResource,
+		newFactMetricResource,
+		newDataSourceResource,
+		newFactTableResource,
+		newAPIKeyResource,
+		newTeamResource,
+		newMemberRoleResource,
+		newRoleResource,
+		newOrganizationSettingsResource,
+		newSDKWebhookResource,
+		newEventWebhookResource,
 	}
 }
 
 func (p *growthbookProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newProjectDataSource,
+		newProjectsDataSource,
 		newEnvironmentDataSource,
 		newEnvironmentsDataSource,
 		newFeatureDataSource,
+		newFeaturesDataSource,
 		newSDKConnectionDataSource,
+		newSDKConnectionsDataSource,
 		newAttributeDataSource,
+		newAttributesDataSource,
+		newSavedGroupDataSource,
+		newMetricDataSource,
+		newFactMetricDataSource,
+		newMembersDataSource,
+		newOrganizationSettingsDataSource,
+	}
+}
+
+func (p *growthbookProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newSDKConnectionSecretsEphemeralResource,
+	}
+}
+
+func (p *growthbookProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newConditionFunction,
 	}
 }
@@ -1,12 +1,15 @@
 package internal_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
 	"testing"
 
 	"terraform-provider-growthbook/internal"
+	"terraform-provider-growthbook/internal/growthbookapi"
+	"terraform-provider-growthbook/internal/growthbookapi/apitest"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	tfprotov6 "github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -14,11 +17,53 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+// testAccProviderFactories serves the provider in-process. When GROWTHBOOK_API_KEY is not set,
+// TestMain points it at testAccMockServer through the GROWTHBOOK_API_* environment variables.
+//
 //nolint:gochecknoglobals
 var testAccProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"growthbook": providerserver.NewProtocol6WithError(internal.New()),
 }
 
+// testAccMockServer is the in-process fake GrowthBook API, nil when testing against a real instance.
+//
+//nolint:gochecknoglobals
+var testAccMockServer *apitest.Server
+
+func TestMain(m *testing.M) {
+	// without credentials, run hermetically against the fake API instead of a GrowthBook instance
+	if os.Getenv("GROWTHBOOK_API_KEY") == "" {
+		testAccMockServer = apitest.NewServer()
+		_ = os.Setenv("GROWTHBOOK_API_URL", testAccMockServer.APIURL())
+		_ = os.Setenv("GROWTHBOOK_API_KEY", testAccMockServer.APIKey)
+	}
+
+	code := m.Run()
+
+	if testAccMockServer != nil {
+		testAccMockServer.Close()
+	}
+	os.Exit(code)
+}
+
+// testAccSkipOnMock skips tests relying on API behavior the fake API does not implement.
+func testAccSkipOnMock(t *testing.T) {
+	t.Helper()
+
+	if testAccMockServer != nil {
+		t.Skip("not supported by the fake GrowthBook API, set GROWTHBOOK_API_KEY to run against a real instance")
+	}
+}
+
+// testAccRequireMock skips tests that inject failures into, or seed objects in, the fake GrowthBook API.
+func testAccRequireMock(t *testing.T) {
+	t.Helper()
+
+	if testAccMockServer == nil {
+		t.Skip("requires the fake GrowthBook API, unset GROWTHBOOK_API_KEY to run")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
@@ -27,6 +72,18 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccEnvOrSkip returns the value of an optional acceptance test variable, skipping the test when unset.
+// It is used for external dependencies the provider cannot create, such as a reachable warehouse.
+func testAccEnvOrSkip(t *testing.T, name string) string {
+	t.Helper()
+
+	v := os.Getenv(name)
+	if v == "" {
+		t.Skip(name + " must be set for this acceptance test")
+	}
+	return v
+}
+
 func testCheckResourceAttrPrefix(resourceName, attr, prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -39,3 +96,44 @@ func testCheckResourceAttrPrefix(resourceName, attr, prefix string) resource.Tes
 		return nil
 	}
 }
+
+// testAccClient returns an API client configured from the same environment variables as the provider,
+// used to make out-of-band changes during acceptance tests.
+func testAccClient() growthbookapi.ClientAPI {
+	apiURL := os.Getenv("GROWTHBOOK_API_URL")
+	if apiURL == "" {
+		apiURL = "https://api.growthbook.io/api/v1"
+	}
+	return growthbookapi.NewClient(apiURL, os.Getenv("GROWTHBOOK_API_KEY"))
+}
+
+// testAccImportIDWithOrganization returns the import ID of a resource of one of the provider organizations,
+// `<id>@<organization>` with the ID read from idAttr.
+func testAccImportIDWithOrganization(resourceName, idAttr, organization string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", resourceName)
+		}
+		return rs.Primary.Attributes[idAttr] + "@" + organization, nil
+	}
+}
+
+// testCheckResourceDisappears deletes the object behind resourceName directly through the API,
+// simulating a deletion made from the GrowthBook UI. idAttr names the attribute holding the object ID.
+func testCheckResourceDisappears(
+	resourceName, idAttr string,
+	del func(ctx context.Context, c growthbookapi.ClientAPI, id string) error,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		id := rs.Primary.Attributes[idAttr]
+		if id == "" {
+			return fmt.Errorf("resource %s has no %s set", resourceName, idAttr)
+		}
+		return del(context.Background(), testAccClient(), id)
+	}
+}
@@ -0,0 +1,284 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &apiKeyResource{}
+var _ resource.ResourceWithImportState = &apiKeyResource{}
+var _ resource.ResourceWithIdentity = &apiKeyResource{}
+var _ resource.ResourceWithValidateConfig = &apiKeyResource{}
+
+func newAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource manages a GrowthBook API key. GrowthBook cannot change API keys, so every change replaces the key,
+// and only returns the key itself on creation.
+type apiKeyResource struct {
+	clients *providerClients
+}
+
+type apiKeyModel struct {
+	ID              types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Type            types.String `tfsdk:"type"`
+	Role            types.String `tfsdk:"role"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Key             types.String `tfsdk:"key"`
+	DateCreated     types.String `tfsdk:"date_created"`
+	Organization    types.String `tfsdk:"organization"`
+}
+
+const (
+	apiKeyTypeSecret   = "secret"
+	apiKeyTypePersonal = "personal"
+	// apiKeyDefaultRole is the role of secret keys without one, the least privileged.
+	apiKeyDefaultRole = "readonly"
+)
+
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a GrowthBook API key. Any change creates a new key and revokes the previous one.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Required:    true,
+				Description: "What the key is used for, e.g. the service it is handed to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(apiKeyTypeSecret),
+				Description: "Either 'secret' (the default), a key acting with its own role, or 'personal', a key " +
+					"acting as the user owning the provider API key.",
+				Validators: []validator.String{
+					stringOneOf(apiKeyTypeSecret, apiKeyTypePersonal),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The role of a secret key, e.g. 'readonly' (the default) or 'admin'. " +
+					"Personal keys have the role of their user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Any change of this value rotates the key, e.g. the ID of a time_rotating resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "The API key, stored in the state. GrowthBook only returns it on creation, so it is " +
+					"null for imported keys and never refreshed. Null when the provider sets omit_api_key_secrets.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func (r *apiKeyResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data apiKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == apiKeyTypePersonal && !data.Role.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("role"),
+			"Unexpected role",
+			"'role' cannot be set when type is 'personal', personal keys have the role of their user.",
+		)
+	}
+}
+
+// apiKeyToModel maps an API key to the state, keeping the attributes GrowthBook does not return from data.
+func apiKeyToModel(data apiKeyModel, k *growthbookapi.APIKey) apiKeyModel {
+	data.ID = types.StringValue(k.ID)
+	data.Description = types.StringValue(k.Description)
+	data.Type = types.StringValue(apiKeyTypePersonal)
+	data.Role = types.StringNull()
+	if k.Secret {
+		data.Type = types.StringValue(apiKeyTypeSecret)
+		data.Role = types.StringValue(k.Role)
+	}
+	if k.Key != "" {
+		data.Key = types.StringValue(k.Key)
+	}
+	data.DateCreated = types.StringValue(k.DateCreated)
+	return data
+}
+
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data apiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := &growthbookapi.APIKey{
+		Description: data.Description.ValueString(),
+		Secret:      data.Type.ValueString() == apiKeyTypeSecret,
+	}
+	if key.Secret {
+		key.Role = apiKeyDefaultRole
+		if !data.Role.IsNull() && !data.Role.IsUnknown() {
+			key.Role = data.Role.ValueString()
+		}
+	}
+
+	created, err := client.CreateAPIKey(ctx, key)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	data.Key = types.StringNull()
+	if r.clients.omitAPIKeySecrets {
+		created.Key = ""
+	}
+	result := apiKeyToModel(data, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data apiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := client.GetAPIKey(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading API key", err.Error())
+		return
+	}
+
+	result := apiKeyToModel(data, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+// Update is not reached in practice: every configurable attribute replaces the key.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data apiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data apiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteAPIKey(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting API key", err.Error())
+	}
+}
+
+func (r *apiKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "description",
+		func(ctx context.Context, client *growthbookapi.Client, description string) (string, error) {
+			keys, err := client.ListAPIKeys(ctx)
+			if err != nil {
+				return "", err
+			}
+			return uniqueIDByName(keys, description,
+				func(k growthbookapi.APIKey) string { return k.Description },
+				func(k growthbookapi.APIKey) string { return k.ID })
+		})
+}
@@ -0,0 +1,124 @@
+package internal_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccAPIKeyConfig(description, rotation string) string {
+	return `
+resource "growthbook_api_key" "test" {
+  description      = "` + description + `"
+  rotation_trigger = "` + rotation + `"
+}
+resource "growthbook_api_key" "admin" {
+  description = "` + description + `-admin"
+  role        = "admin"
+}
+`
+}
+
+func TestAccGrowthBookAPIKey_basic(t *testing.T) {
+	t.Parallel()
+
+	description := acctest.RandomWithPrefix("tf-acc-key-")
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig(description, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_api_key.test", "key", "secret_"),
+					resource.TestCheckResourceAttr("growthbook_api_key.test", "type", "secret"),
+					resource.TestCheckResourceAttr("growthbook_api_key.test", "role", "readonly"),
+					resource.TestCheckResourceAttr("growthbook_api_key.admin", "role", "admin"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["growthbook_api_key.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig(description, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_api_key.test", "key", "secret_"),
+					resource.TestCheckResourceAttrWith("growthbook_api_key.test", "id", func(v string) error {
+						if v == firstID {
+							return fmt.Errorf("expected a new key after rotation, still %s", v)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            "growthbook_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "rotation_trigger"},
+			},
+			{
+				ResourceName:            "growthbook_api_key.admin",
+				ImportState:             true,
+				ImportStateId:           "description:" + description + "-admin",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookAPIKey_personalRole(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "growthbook_api_key" "test" {
+  description = "personal"
+  type        = "personal"
+  role        = "admin"
+}
+`,
+				ExpectError: regexp.MustCompile("Unexpected role"),
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookAPIKey_omitSecrets(t *testing.T) {
+	t.Parallel()
+
+	description := acctest.RandomWithPrefix("tf-acc-key-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "growthbook" {
+  omit_api_key_secrets = true
+}
+resource "growthbook_api_key" "test" {
+  description = "` + description + `"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("growthbook_api_key.test", "id"),
+					resource.TestCheckNoResourceAttr("growthbook_api_key.test", "key"),
+				),
+			},
+		},
+	})
+}
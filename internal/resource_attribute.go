@@ -2,9 +2,9 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"slices"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,23 +14,25 @@ import (
 
 var _ resource.Resource = &attributeResource{}
 var _ resource.ResourceWithImportState = &attributeResource{}
+var _ resource.ResourceWithIdentity = &attributeResource{}
 
 func newAttributeResource() resource.Resource {
 	return &attributeResource{}
 }
 
 type attributeResource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type attributeModel struct {
-	Property    types.String `tfsdk:"property"`
-	DataType    types.String `tfsdk:"datatype"`
-	Format      types.String `tfsdk:"format"`
-	EnumValues  types.String `tfsdk:"enum_values"`
-	Projects    types.List   `tfsdk:"projects"`
-	Archived    types.Bool   `tfsdk:"archived"`
-	Description types.String `tfsdk:"description"`
+	Property     types.String `tfsdk:"property"`
+	DataType     types.String `tfsdk:"datatype"`
+	Format       types.String `tfsdk:"format"`
+	EnumValues   types.String `tfsdk:"enum_values"`
+	Projects     types.List   `tfsdk:"projects"`
+	Archived     types.Bool   `tfsdk:"archived"`
+	Description  types.String `tfsdk:"description"`
+	Organization types.String `tfsdk:"organization"`
 }
 
 func (r *attributeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,6 +42,7 @@ func (r *attributeResource) Metadata(_ context.Context, req resource.MetadataReq
 func (r *attributeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
 			"property": schema.StringAttribute{
 				Required: true,
 			},
@@ -76,12 +79,12 @@ func (r *attributeResource) Configure(_ context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	r.client = client
+	r.clients = clients
 }
 
 var validFormats = []string{"", "version", "date", "isoCountryCode"}
@@ -93,6 +96,12 @@ func (r *attributeResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	format := data.Format.ValueString()
 	if !slices.Contains(validFormats, format) {
 		resp.Diagnostics.AddError(
@@ -120,7 +129,7 @@ func (r *attributeResource) Create(ctx context.Context, req resource.CreateReque
 		Description: data.Description.ValueString(),
 	}
 
-	created, err := r.client.CreateAttribute(ctx, attribute)
+	created, err := client.CreateAttribute(ctx, attribute)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating attribute", err.Error())
 		return
@@ -135,6 +144,7 @@ func (r *attributeResource) Create(ctx context.Context, req resource.CreateReque
 	data.Description = types.StringValue(created.Description)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{"property": data.Property})...)
 }
 
 func (r *attributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,7 +154,17 @@ func (r *attributeResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	out, err := r.client.GetAttribute(ctx, data.Property.ValueString())
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := client.GetAttribute(ctx, data.Property.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading attribute", err.Error())
 		return
@@ -163,6 +183,7 @@ func (r *attributeResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.Description = types.StringValue(out.Description)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{"property": data.Property})...)
 }
 
 func (r *attributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -172,6 +193,12 @@ func (r *attributeResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projects := []string{}
 	if !data.Projects.IsNull() && !data.Projects.IsUnknown() {
 		resp.Diagnostics.Append(data.Projects.ElementsAs(ctx, &projects, false)...)
@@ -190,13 +217,14 @@ func (r *attributeResource) Update(ctx context.Context, req resource.UpdateReque
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.UpdateAttribute(ctx, attribute.Property, attribute)
+	_, err := client.UpdateAttribute(ctx, attribute.Property, attribute)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating attribute", err.Error())
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{"property": data.Property})...)
 }
 
 func (r *attributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -206,11 +234,28 @@ func (r *attributeResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	if err := r.client.DeleteAttribute(ctx, data.Property.ValueString()); err != nil {
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteAttribute(ctx, data.Property.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
 		resp.Diagnostics.AddError("Error deleting attribute", err.Error())
 	}
 }
 
+func (r *attributeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("property")
+}
+
 func (r *attributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("property"), req, resp)
+	importWithLookup(ctx, req, resp, r.clients, "property", "property", func(ctx context.Context, client *growthbookapi.Client, property string) (string, error) {
+		a, err := client.GetAttribute(ctx, property)
+		if err != nil {
+			return "", err
+		}
+		return a.Property, nil
+	})
 }
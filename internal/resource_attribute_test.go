@@ -1,10 +1,13 @@
 package internal_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
+	"terraform-provider-growthbook/internal/growthbookapi"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -43,6 +46,43 @@ data "growthbook_attribute" "by_property" {
 }
 
 // generateManyAttributes generates N growthbook_attribute HCL resources with the given property prefix.
+func TestAccDataSourceGrowthBookAttributes_filters(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf_acc_attrs_")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "growthbook_project" "test" {
+  name = "` + prefix + `-proj"
+}
+resource "growthbook_attribute" "number" {
+  property = "` + prefix + `_number"
+  datatype = "number"
+  projects = [growthbook_project.test.id]
+}
+resource "growthbook_attribute" "string" {
+  property = "` + prefix + `_string"
+  datatype = "string"
+  projects = [growthbook_project.test.id]
+}
+data "growthbook_attributes" "numbers" {
+  datatype   = "number"
+  project    = growthbook_project.test.id
+  depends_on = [growthbook_attribute.number, growthbook_attribute.string]
+}
+`,
+				Check: resource.TestCheckTypeSetElemNestedAttrs("data.growthbook_attributes.numbers", "attributes.*",
+					map[string]string{"property": prefix + "_number", "datatype": "number"}),
+			},
+		},
+	})
+}
+
 func generateManyAttributes(n int, prefix string) string {
 	var b strings.Builder
 	b.Grow(n * 120)
@@ -104,3 +144,35 @@ data "growthbook_attribute" "attr_10" {
 		},
 	})
 }
+
+func TestAccGrowthBookAttribute_disappears(t *testing.T) {
+	t.Parallel()
+
+	property := acctest.RandomWithPrefix("tf-acc-attr-")
+	config := `
+resource "growthbook_attribute" "test" {
+  property = "` + property + `"
+  datatype = "string"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckResourceDisappears("growthbook_attribute.test", "property",
+					func(ctx context.Context, c growthbookapi.ClientAPI, id string) error {
+						return c.DeleteAttribute(ctx, id)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("growthbook_attribute.test", "property", property),
+			},
+		},
+	})
+}
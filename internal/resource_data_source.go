@@ -0,0 +1,477 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &dataSourceResource{}
+var _ resource.ResourceWithImportState = &dataSourceResource{}
+var _ resource.ResourceWithIdentity = &dataSourceResource{}
+
+func newDataSourceResource() resource.Resource {
+	return &dataSourceResource{}
+}
+
+// dataSourceResource manages a GrowthBook data source (warehouse connection),
+// not to be confused with Terraform data sources.
+type dataSourceResource struct {
+	clients *providerClients
+}
+
+type dataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	Description     types.String `tfsdk:"description"`
+	Projects        types.List   `tfsdk:"projects"`
+	EventTracker    types.String `tfsdk:"event_tracker"`
+	ParamsWO        types.Map    `tfsdk:"params_wo"`
+	ParamsWOVersion types.Int64  `tfsdk:"params_wo_version"`
+	IdentifierTypes types.List   `tfsdk:"identifier_types"`
+	ExposureQueries types.List   `tfsdk:"exposure_queries"`
+	DateCreated     types.String `tfsdk:"date_created"`
+	DateUpdated     types.String `tfsdk:"date_updated"`
+	Organization    types.String `tfsdk:"organization"`
+}
+
+type dataSourceIdentifierTypeModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+}
+
+// dataSourceExposureQueryModel maps an experiment assignment query of a data source.
+type dataSourceExposureQueryModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	IdentifierType      types.String `tfsdk:"identifier_type"`
+	SQL                 types.String `tfsdk:"sql"`
+	DimensionColumns    types.List   `tfsdk:"dimension_columns"`
+	IncludesNameColumns types.Bool   `tfsdk:"includes_name_columns"`
+}
+
+//nolint:gochecknoglobals
+var validDataSourceTypes = []string{
+	"redshift", "athena", "google_analytics", "snowflake", "postgres", "mysql", "mssql",
+	"bigquery", "clickhouse", "presto", "databricks", "mixpanel", "vertica",
+}
+
+func dataSourceIdentifierTypeObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"description": types.StringType,
+	}}
+}
+
+func dataSourceExposureQueryObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":                    types.StringType,
+		"name":                  types.StringType,
+		"description":           types.StringType,
+		"identifier_type":       types.StringType,
+		"sql":                   types.StringType,
+		"dimension_columns":     types.ListType{ElemType: types.StringType},
+		"includes_name_columns": types.BoolType,
+	}}
+}
+
+func (r *dataSourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_source"
+}
+
+func (r *dataSourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a GrowthBook data source, the connection to a data warehouse or analytics tool.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Warehouse type, e.g. 'bigquery', 'snowflake', 'postgres' or 'mixpanel'.",
+				Validators: []validator.String{
+					stringOneOf(validDataSourceTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Array of project IDs.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_tracker": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Event tracker writing to the warehouse, e.g. 'segment' or 'rudderstack'.",
+			},
+			"params_wo": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Connection params (host, database, credentials, ...). Never stored in state, " +
+					"only sent on creation and when params_wo_version changes.",
+			},
+			"params_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change this value to send params_wo again.",
+			},
+			"identifier_types": schema.ListNestedAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "Identifier column name, e.g. 'user_id'.",
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"exposure_queries": schema.ListNestedAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Query ID, referenced by experiments as assignment_query_id.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"identifier_type": schema.StringAttribute{
+							Required: true,
+						},
+						"sql": schema.StringAttribute{
+							Required: true,
+						},
+						"dimension_columns": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+						},
+						"includes_name_columns": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *dataSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+// dataSourceFromPlan builds the API payload. params is the write-only value read from config,
+// it is only included when non-null.
+func dataSourceFromPlan(
+	ctx context.Context,
+	data dataSourceModel,
+	params types.Map,
+) (*growthbookapi.DataSourceBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projects, d := listToStrings(ctx, data.Projects)
+	diags.Append(d...)
+
+	body := &growthbookapi.DataSourceBody{
+		Name:         data.Name.ValueString(),
+		Type:         data.Type.ValueString(),
+		Description:  data.Description.ValueString(),
+		ProjectIDs:   projects,
+		EventTracker: data.EventTracker.ValueString(),
+	}
+	if !params.IsNull() && !params.IsUnknown() {
+		diags.Append(params.ElementsAs(ctx, &body.Params, false)...)
+	}
+
+	if !data.IdentifierTypes.IsNull() && !data.IdentifierTypes.IsUnknown() {
+		var identifierTypes []dataSourceIdentifierTypeModel
+		diags.Append(data.IdentifierTypes.ElementsAs(ctx, &identifierTypes, false)...)
+		for _, t := range identifierTypes {
+			body.IdentifierTypes = append(body.IdentifierTypes, growthbookapi.DataSourceIdentifierType{
+				ID:          t.ID.ValueString(),
+				Description: t.Description.ValueString(),
+			})
+		}
+	}
+
+	if !data.ExposureQueries.IsNull() && !data.ExposureQueries.IsUnknown() {
+		var queries []dataSourceExposureQueryModel
+		diags.Append(data.ExposureQueries.ElementsAs(ctx, &queries, false)...)
+		for _, q := range queries {
+			dimensions, d := listToStrings(ctx, q.DimensionColumns)
+			diags.Append(d...)
+			body.AssignmentQueries = append(body.AssignmentQueries, growthbookapi.DataSourceAssignmentQuery{
+				ID:                  q.ID.ValueString(),
+				Name:                q.Name.ValueString(),
+				Description:         q.Description.ValueString(),
+				IdentifierType:      q.IdentifierType.ValueString(),
+				SQL:                 q.SQL.ValueString(),
+				DimensionColumns:    dimensions,
+				IncludesNameColumns: q.IncludesNameColumns.ValueBool(),
+			})
+		}
+	}
+
+	return body, diags
+}
+
+// dataSourceModelFromAPI populates a dataSourceModel from a GrowthBook API DataSource.
+// params_wo and params_wo_version are left untouched since the API never returns connection params.
+func dataSourceModelFromAPI(ctx context.Context, m *dataSourceModel, ds *growthbookapi.DataSource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(ds.ID)
+	m.Name = types.StringValue(ds.Name)
+	m.Type = types.StringValue(ds.Type)
+	m.Description = types.StringValue(ds.Description)
+	m.Projects = stringsToList(ctx, ds.ProjectIDs)
+	m.EventTracker = types.StringValue(ds.EventTracker)
+	m.ParamsWO = types.MapNull(types.StringType)
+	m.DateCreated = types.StringValue(ds.DateCreated)
+	m.DateUpdated = types.StringValue(ds.DateUpdated)
+
+	identifierTypes := make([]dataSourceIdentifierTypeModel, len(ds.IdentifierTypes))
+	for i, t := range ds.IdentifierTypes {
+		identifierTypes[i] = dataSourceIdentifierTypeModel{
+			ID:          types.StringValue(t.ID),
+			Description: types.StringValue(t.Description),
+		}
+	}
+	var d diag.Diagnostics
+	m.IdentifierTypes, d = types.ListValueFrom(ctx, dataSourceIdentifierTypeObjectType(), identifierTypes)
+	diags.Append(d...)
+
+	queries := make([]dataSourceExposureQueryModel, len(ds.AssignmentQueries))
+	for i, q := range ds.AssignmentQueries {
+		queries[i] = dataSourceExposureQueryModel{
+			ID:                  types.StringValue(q.ID),
+			Name:                types.StringValue(q.Name),
+			Description:         types.StringValue(q.Description),
+			IdentifierType:      types.StringValue(q.IdentifierType),
+			SQL:                 types.StringValue(q.SQL),
+			DimensionColumns:    stringsToList(ctx, q.DimensionColumns),
+			IncludesNameColumns: types.BoolValue(q.IncludesNameColumns),
+		}
+	}
+	m.ExposureQueries, d = types.ListValueFrom(ctx, dataSourceExposureQueryObjectType(), queries)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *dataSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dataSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in config
+	var params types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("params_wo"), &params)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := dataSourceFromPlan(ctx, data, params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateDataSource(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating data source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(dataSourceModelFromAPI(ctx, &data, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *dataSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ds, err := client.GetDataSource(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading data source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(dataSourceModelFromAPI(ctx, &data, ds)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *dataSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state dataSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// connection params are only sent again when explicitly requested through params_wo_version
+	params := types.MapNull(types.StringType)
+	if !data.ParamsWOVersion.Equal(state.ParamsWOVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("params_wo"), &params)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	body, diags := dataSourceFromPlan(ctx, data, params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateDataSource(ctx, state.ID.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating data source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(dataSourceModelFromAPI(ctx, &data, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *dataSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteDataSource(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting data source", err.Error())
+	}
+}
+
+func (r *dataSourceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *dataSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		items, err := client.ListDataSources(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(items, name,
+			func(v growthbookapi.DataSource) string { return v.Name },
+			func(v growthbookapi.DataSource) string { return v.ID })
+	})
+}
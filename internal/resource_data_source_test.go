@@ -0,0 +1,83 @@
+package internal_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccDataSourceConfig returns a postgres growthbook_data_source named "test". GrowthBook checks the
+// connection when a data source is saved, so it needs a reachable database given by GROWTHBOOK_ACC_POSTGRES_*.
+func testAccDataSourceConfig(t *testing.T, name, description string) string {
+	t.Helper()
+	testAccSkipOnMock(t)
+
+	host := testAccEnvOrSkip(t, "GROWTHBOOK_ACC_POSTGRES_HOST")
+	return `
+resource "growthbook_data_source" "test" {
+  name        = "` + name + `"
+  type        = "postgres"
+  description = "` + description + `"
+
+  params_wo = {
+    host     = "` + host + `"
+    port     = "5432"
+    database = "` + os.Getenv("GROWTHBOOK_ACC_POSTGRES_DATABASE") + `"
+    user     = "` + os.Getenv("GROWTHBOOK_ACC_POSTGRES_USER") + `"
+    password = "` + os.Getenv("GROWTHBOOK_ACC_POSTGRES_PASSWORD") + `"
+  }
+  params_wo_version = 1
+
+  identifier_types = [
+    { id = "user_id", description = "Logged-in user" },
+    { id = "anonymous_id" },
+  ]
+
+  exposure_queries = [
+    {
+      name            = "Logged-in users"
+      identifier_type = "user_id"
+      sql             = "SELECT user_id, timestamp, experiment_id, variation_id FROM experiment_viewed"
+    },
+  ]
+}
+`
+}
+
+func TestAccGrowthBookDataSource_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-ds-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig(t, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_data_source.test", "id", "ds_"),
+					resource.TestCheckResourceAttr("growthbook_data_source.test", "type", "postgres"),
+					resource.TestCheckResourceAttr("growthbook_data_source.test", "identifier_types.#", "2"),
+					resource.TestCheckResourceAttr("growthbook_data_source.test", "exposure_queries.#", "1"),
+					resource.TestCheckResourceAttrSet("growthbook_data_source.test", "exposure_queries.0.id"),
+					resource.TestCheckNoResourceAttr("growthbook_data_source.test", "params_wo"),
+				),
+			},
+			{
+				Config: testAccDataSourceConfig(t, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_data_source.test", "description", "second"),
+				),
+			},
+			{
+				ResourceName:            "growthbook_data_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"params_wo_version"},
+			},
+		},
+	})
+}
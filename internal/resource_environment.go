@@ -2,9 +2,9 @@ package internal
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,13 +16,14 @@ import (
 
 var _ resource.Resource = &environmentResource{}
 var _ resource.ResourceWithImportState = &environmentResource{}
+var _ resource.ResourceWithIdentity = &environmentResource{}
 
 func newEnvironmentResource() resource.Resource {
 	return &environmentResource{}
 }
 
 type environmentResource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type environmentModel struct {
@@ -32,6 +33,7 @@ type environmentModel struct {
 	ToggleOnList types.Bool   `tfsdk:"toggle_on_list"`
 	DefaultState types.Bool   `tfsdk:"default_state"`
 	Projects     types.List   `tfsdk:"projects"`
+	Organization types.String `tfsdk:"organization"`
 }
 
 func (r *environmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +43,7 @@ func (r *environmentResource) Metadata(_ context.Context, req resource.MetadataR
 func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -78,12 +81,12 @@ func (r *environmentResource) Configure(_ context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	r.client = client
+	r.clients = clients
 }
 
 func (r *environmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -93,6 +96,12 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	env := &growthbookapi.Environment{
 		ID:           data.Name.ValueString(),
 		Description:  data.Description.ValueString(),
@@ -108,7 +117,7 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	env.Projects = projects
 
-	created, err := r.client.CreateEnvironment(ctx, env)
+	created, err := client.CreateEnvironment(ctx, env)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating environment", err.Error())
 		return
@@ -122,6 +131,7 @@ func (r *environmentResource) Create(ctx context.Context, req resource.CreateReq
 	data.Projects = stringsToList(ctx, created.Projects)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -131,7 +141,17 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	env, err := r.client.FindEnvironmentByID(ctx, data.ID.ValueString())
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, err := client.FindEnvironmentByID(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading environment", err.Error())
 		return
@@ -144,6 +164,7 @@ func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 	data.Projects = stringsToList(ctx, env.Projects)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *environmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -153,6 +174,12 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state environmentModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -173,7 +200,7 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	env.Projects = projects
 
-	_, err := r.client.UpdateEnvironment(ctx, state.ID.ValueString(), env)
+	_, err := client.UpdateEnvironment(ctx, state.ID.ValueString(), env)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating environment", err.Error())
 		return
@@ -181,6 +208,7 @@ func (r *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 
 	data.ID = state.ID
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -190,13 +218,30 @@ func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	if err := r.client.DeleteEnvironment(ctx, data.ID.ValueString()); err != nil {
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteEnvironment(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
 		resp.Diagnostics.AddError("Error deleting environment", err.Error())
 	}
 }
 
+func (r *environmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
 func (r *environmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importWithLookup(ctx, req, resp, r.clients, "id", "env", func(ctx context.Context, client *growthbookapi.Client, id string) (string, error) {
+		env, err := client.FindEnvironmentByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return env.ID, nil
+	})
 }
 
 // stringsToList converts a []string to a types.List of strings.
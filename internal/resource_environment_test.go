@@ -1,8 +1,11 @@
 package internal_test
 
 import (
+	"context"
 	"testing"
 
+	"terraform-provider-growthbook/internal/growthbookapi"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -87,3 +90,35 @@ func TestAccGrowthBookEnvironment_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccGrowthBookEnvironment_disappears(t *testing.T) {
+	t.Parallel()
+
+	envName := acctest.RandomWithPrefix("tf-acc-env-")
+	config := `
+resource "growthbook_environment" "test" {
+  name        = "` + envName + `"
+  description = "Acceptance test environment"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckResourceDisappears("growthbook_environment.test", "id",
+					func(ctx context.Context, c growthbookapi.ClientAPI, id string) error {
+						return c.DeleteEnvironment(ctx, id)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("growthbook_environment.test", "id", envName),
+			},
+		},
+	})
+}
@@ -0,0 +1,310 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &eventWebhookResource{}
+var _ resource.ResourceWithImportState = &eventWebhookResource{}
+var _ resource.ResourceWithIdentity = &eventWebhookResource{}
+
+func newEventWebhookResource() resource.Resource {
+	return &eventWebhookResource{}
+}
+
+type eventWebhookResource struct {
+	clients *providerClients
+}
+
+type eventWebhookModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	URL           types.String `tfsdk:"url"`
+	Events        types.List   `tfsdk:"events"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Projects      types.List   `tfsdk:"projects"`
+	Environments  types.List   `tfsdk:"environments"`
+	Tags          types.List   `tfsdk:"tags"`
+	PayloadFormat types.String `tfsdk:"payload_format"`
+	Method        types.String `tfsdk:"method"`
+	Headers       types.Map    `tfsdk:"headers"`
+	SigningKey    types.String `tfsdk:"signing_key"`
+	DateCreated   types.String `tfsdk:"date_created"`
+	Organization  types.String `tfsdk:"organization"`
+}
+
+func (r *eventWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_webhook"
+}
+
+func (r *eventWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a GrowthBook event webhook, called on events such as 'feature.updated'.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL called by the webhook, e.g. a Slack or Discord incoming webhook.",
+			},
+			"events": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The events calling the webhook, e.g. 'feature.updated' or 'experiment.warning'.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only calls the webhook for events of these project IDs. Empty means all projects.",
+			},
+			"environments": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only calls the webhook for events of these environments. Empty means all environments.",
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only calls the webhook for events of objects with these tags. Empty means all tags.",
+			},
+			"payload_format": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("raw"),
+				Description: "The body of calls: 'raw' (the default), 'json', 'slack', 'discord' or 'ms-teams'.",
+				Validators: []validator.String{
+					stringOneOf("raw", "json", "slack", "discord", "ms-teams"),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("POST"),
+				Description: "The HTTP method of calls: POST (the default), PUT or PATCH.",
+				Validators: []validator.String{
+					stringOneOf("POST", "PUT", "PATCH"),
+				},
+			},
+			"headers":     webhookHeadersAttribute(),
+			"signing_key": webhookSigningKeyAttribute(),
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *eventWebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func eventWebhookFromPlan(ctx context.Context, data eventWebhookModel) (*growthbookapi.EventWebhook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	events, d := listToStrings(ctx, data.Events)
+	diags.Append(d...)
+	projects, d := listToStrings(ctx, data.Projects)
+	diags.Append(d...)
+	environments, d := listToStrings(ctx, data.Environments)
+	diags.Append(d...)
+	tags, d := listToStrings(ctx, data.Tags)
+	diags.Append(d...)
+	headers, d := headersToAPI(ctx, data.Headers)
+	diags.Append(d...)
+
+	return &growthbookapi.EventWebhook{
+		Name:         data.Name.ValueString(),
+		URL:          data.URL.ValueString(),
+		Events:       events,
+		Enabled:      data.Enabled.ValueBool(),
+		Projects:     projects,
+		Environments: environments,
+		Tags:         tags,
+		PayloadType:  data.PayloadFormat.ValueString(),
+		Method:       data.Method.ValueString(),
+		Headers:      headers,
+	}, diags
+}
+
+// eventWebhookToModel populates m from a webhook, keeping its organization.
+func eventWebhookToModel(ctx context.Context, m *eventWebhookModel, w *growthbookapi.EventWebhook) diag.Diagnostics {
+	m.ID = types.StringValue(w.ID)
+	m.Name = types.StringValue(w.Name)
+	m.URL = types.StringValue(w.URL)
+	m.Events = stringsToList(ctx, w.Events)
+	m.Enabled = types.BoolValue(w.Enabled)
+	m.Projects = stringsToList(ctx, w.Projects)
+	m.Environments = stringsToList(ctx, w.Environments)
+	m.Tags = stringsToList(ctx, w.Tags)
+	m.PayloadFormat = types.StringValue(w.PayloadType)
+	m.Method = types.StringValue(w.Method)
+	m.SigningKey = types.StringValue(w.SigningKey)
+	m.DateCreated = types.StringValue(w.DateCreated)
+
+	var diags diag.Diagnostics
+	m.Headers, diags = headersFromAPI(ctx, w.Headers)
+	return diags
+}
+
+func (r *eventWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data eventWebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, diags := eventWebhookFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateEventWebhook(ctx, webhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating event webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(eventWebhookToModel(ctx, &data, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *eventWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data eventWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := client.GetEventWebhook(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading event webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(eventWebhookToModel(ctx, &data, webhook)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *eventWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data eventWebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, diags := eventWebhookFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateEventWebhook(ctx, data.ID.ValueString(), webhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating event webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(eventWebhookToModel(ctx, &data, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *eventWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data eventWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteEventWebhook(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting event webhook", err.Error())
+	}
+}
+
+func (r *eventWebhookResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *eventWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		webhooks, err := client.ListEventWebhooks(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(webhooks, name,
+			func(w growthbookapi.EventWebhook) string { return w.Name },
+			func(w growthbookapi.EventWebhook) string { return w.ID })
+	})
+}
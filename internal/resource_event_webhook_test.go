@@ -0,0 +1,64 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccEventWebhookConfig(name, format string) string {
+	return `
+resource "growthbook_project" "test" {
+  name = "` + name + `-proj"
+}
+resource "growthbook_event_webhook" "test" {
+  name           = "` + name + `"
+  url            = "https://hooks.example.com/growthbook"
+  events         = ["feature.updated", "feature.deleted"]
+  projects       = [growthbook_project.test.id]
+  environments   = ["production"]
+  payload_format = "` + format + `"
+}
+`
+}
+
+func TestAccGrowthBookEventWebhook_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-eventwebhook-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventWebhookConfig(name, "slack"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_event_webhook.test", "events.#", "2"),
+					resource.TestCheckResourceAttr("growthbook_event_webhook.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("growthbook_event_webhook.test", "payload_format", "slack"),
+					resource.TestCheckResourceAttr("growthbook_event_webhook.test", "tags.#", "0"),
+					resource.TestCheckResourceAttrPair("growthbook_event_webhook.test", "projects.0",
+						"growthbook_project.test", "id"),
+					resource.TestCheckResourceAttrSet("growthbook_event_webhook.test", "signing_key"),
+				),
+			},
+			{
+				Config: testAccEventWebhookConfig(name, "discord"),
+				Check:  resource.TestCheckResourceAttr("growthbook_event_webhook.test", "payload_format", "discord"),
+			},
+			{
+				ResourceName:      "growthbook_event_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "growthbook_event_webhook.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,579 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &experimentResource{}
+var _ resource.ResourceWithImportState = &experimentResource{}
+var _ resource.ResourceWithIdentity = &experimentResource{}
+var _ resource.ResourceWithModifyPlan = &experimentResource{}
+
+func newExperimentResource() resource.Resource {
+	return &experimentResource{}
+}
+
+type experimentResource struct {
+	clients *providerClients
+}
+
+type experimentModel struct {
+	ID                types.String               `tfsdk:"id"`
+	TrackingKey       types.String               `tfsdk:"tracking_key"`
+	Name              types.String               `tfsdk:"name"`
+	Project           types.String               `tfsdk:"project"`
+	Hypothesis        types.String               `tfsdk:"hypothesis"`
+	Description       types.String               `tfsdk:"description"`
+	Tags              types.List                 `tfsdk:"tags"`
+	Owner             types.String               `tfsdk:"owner"`
+	Archived          types.Bool                 `tfsdk:"archived"`
+	Status            types.String               `tfsdk:"status"`
+	HashAttribute     types.String               `tfsdk:"hash_attribute"`
+	DatasourceID      types.String               `tfsdk:"datasource_id"`
+	AssignmentQueryID types.String               `tfsdk:"assignment_query_id"`
+	Metrics           types.List                 `tfsdk:"metrics"`
+	SecondaryMetrics  types.List                 `tfsdk:"secondary_metrics"`
+	GuardrailMetrics  types.List                 `tfsdk:"guardrail_metrics"`
+	Variations        []experimentVariationModel `tfsdk:"variations"`
+	Phases            types.List                 `tfsdk:"phases"`
+	DateCreated       types.String               `tfsdk:"date_created"`
+	DateUpdated       types.String               `tfsdk:"date_updated"`
+	Organization      types.String               `tfsdk:"organization"`
+}
+
+// experimentVariationModel maps a single experiment variation.
+type experimentVariationModel struct {
+	ID          types.String `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// experimentPhaseModel maps a single experiment phase.
+type experimentPhaseModel struct {
+	Name              types.String  `tfsdk:"name"`
+	DateStarted       types.String  `tfsdk:"date_started"`
+	DateEnded         types.String  `tfsdk:"date_ended"`
+	ReasonForStopping types.String  `tfsdk:"reason_for_stopping"`
+	Coverage          types.Float64 `tfsdk:"coverage"`
+	TrafficSplit      types.List    `tfsdk:"traffic_split"`
+	Condition         types.String  `tfsdk:"condition"`
+}
+
+func experimentPhaseObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":                types.StringType,
+		"date_started":        types.StringType,
+		"date_ended":          types.StringType,
+		"reason_for_stopping": types.StringType,
+		"coverage":            types.Float64Type,
+		"traffic_split":       types.ListType{ElemType: types.Float64Type},
+		"condition":           types.StringType,
+	}}
+}
+
+func (r *experimentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_experiment"
+}
+
+func (r *experimentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a GrowthBook experiment. Experiments cannot be deleted through the API, " +
+			"destroying this resource archives the experiment.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tracking_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Key used to identify the experiment in tracking callbacks.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"hypothesis": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"archived": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "One of 'draft', 'running' or 'stopped'.",
+				Validators: []validator.String{
+					stringOneOf("draft", "running", "stopped"),
+				},
+			},
+			"hash_attribute": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute used to assign users to variations.",
+			},
+			"datasource_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"assignment_query_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"metrics": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Goal metric IDs.",
+			},
+			"secondary_metrics": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"guardrail_metrics": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"variations": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Variation ID generated by GrowthBook, kept for the same variation key.",
+						},
+						"key": schema.StringAttribute{
+							Required: true,
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"phases": schema.ListNestedAttribute{
+				Optional: true,
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"date_started": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "ISO 8601 date-time the phase started.",
+						},
+						"date_ended": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "ISO 8601 date-time the phase ended.",
+						},
+						"reason_for_stopping": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"coverage": schema.Float64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Fraction of traffic included in the experiment, between 0 and 1.",
+						},
+						"traffic_split": schema.ListAttribute{
+							ElementType: types.Float64Type,
+							Optional:    true,
+							Computed:    true,
+							Description: "Weight of each variation, in the same order as variations.",
+						},
+						"condition": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "JSON targeting condition.",
+						},
+					},
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *experimentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func (r *experimentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data experimentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := experimentFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateExperiment(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating experiment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(experimentModelFromAPI(ctx, &data, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *experimentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data experimentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	experiment, err := client.GetExperiment(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading experiment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(experimentModelFromAPI(ctx, &data, experiment)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *experimentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data experimentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state experimentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := experimentFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateExperiment(ctx, state.ID.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating experiment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(experimentModelFromAPI(ctx, &data, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *experimentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data experimentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.ArchiveExperiment(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error archiving experiment", err.Error())
+	}
+}
+
+// ModifyPlan plans the variation IDs from state by variation key rather than by position, so that inserting, removing
+// or reordering variations keeps the IDs of the others. Variations with a new key get a new ID.
+func (r *experimentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	// variations computed from values known after apply only, e.g. a for expression over the output of another
+	// resource, get their IDs at apply time
+	var variations types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("variations"), &variations)...)
+	if resp.Diagnostics.HasError() || variations.IsUnknown() || variations.IsNull() {
+		return
+	}
+	for _, v := range variations.Elements() {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	var planned, prior []experimentVariationModel
+	resp.Diagnostics.Append(variations.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("variations"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make(map[string]types.String, len(prior))
+	for _, v := range prior {
+		ids[v.Key.ValueString()] = v.ID
+	}
+	for i, v := range planned {
+		id, ok := ids[v.Key.ValueString()]
+		if !ok || v.Key.IsUnknown() {
+			id = types.StringUnknown()
+		}
+		planned[i].ID = id
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("variations"), planned)...)
+}
+
+func (r *experimentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *experimentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		items, err := client.ListExperiments(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(items, name,
+			func(v growthbookapi.Experiment) string { return v.Name },
+			func(v growthbookapi.Experiment) string { return v.ID })
+	})
+}
+
+// listToStrings reads a known list of strings, returning an empty slice for null or unknown lists.
+func listToStrings(ctx context.Context, l types.List) ([]string, diag.Diagnostics) {
+	out := []string{}
+	if l.IsNull() || l.IsUnknown() {
+		return out, nil
+	}
+	diags := l.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+// experimentFromPlan builds the API payload from a Terraform plan.
+// Variation IDs known from state are sent back so GrowthBook keeps them stable.
+func experimentFromPlan(ctx context.Context, data experimentModel) (*growthbookapi.ExperimentBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := listToStrings(ctx, data.Tags)
+	diags.Append(d...)
+	metrics, d := listToStrings(ctx, data.Metrics)
+	diags.Append(d...)
+	secondary, d := listToStrings(ctx, data.SecondaryMetrics)
+	diags.Append(d...)
+	guardrails, d := listToStrings(ctx, data.GuardrailMetrics)
+	diags.Append(d...)
+
+	body := &growthbookapi.ExperimentBody{
+		TrackingKey:       data.TrackingKey.ValueString(),
+		Name:              data.Name.ValueString(),
+		Project:           data.Project.ValueString(),
+		Hypothesis:        data.Hypothesis.ValueString(),
+		Description:       data.Description.ValueString(),
+		Tags:              tags,
+		Owner:             data.Owner.ValueString(),
+		Archived:          data.Archived.ValueBool(),
+		Status:            data.Status.ValueString(),
+		HashAttribute:     data.HashAttribute.ValueString(),
+		DatasourceID:      data.DatasourceID.ValueString(),
+		AssignmentQueryID: data.AssignmentQueryID.ValueString(),
+		Metrics:           metrics,
+		SecondaryMetrics:  secondary,
+		GuardrailMetrics:  guardrails,
+		Variations:        make([]growthbookapi.ExperimentVariationBody, len(data.Variations)),
+	}
+	for i, v := range data.Variations {
+		body.Variations[i] = growthbookapi.ExperimentVariationBody{
+			ID:          v.ID.ValueString(),
+			Key:         v.Key.ValueString(),
+			Name:        v.Name.ValueString(),
+			Description: v.Description.ValueString(),
+		}
+	}
+
+	if !data.Phases.IsNull() && !data.Phases.IsUnknown() {
+		var phases []experimentPhaseModel
+		diags.Append(data.Phases.ElementsAs(ctx, &phases, false)...)
+		for _, p := range phases {
+			phase := growthbookapi.ExperimentPhaseBody{
+				Name:              p.Name.ValueString(),
+				DateStarted:       p.DateStarted.ValueString(),
+				DateEnded:         p.DateEnded.ValueString(),
+				ReasonForStopping: p.ReasonForStopping.ValueString(),
+				Condition:         p.Condition.ValueString(),
+			}
+			if !p.Coverage.IsNull() && !p.Coverage.IsUnknown() {
+				v := p.Coverage.ValueFloat64()
+				phase.Coverage = &v
+			}
+			if !p.TrafficSplit.IsNull() && !p.TrafficSplit.IsUnknown() {
+				diags.Append(p.TrafficSplit.ElementsAs(ctx, &phase.VariationWeights, false)...)
+			}
+			body.Phases = append(body.Phases, phase)
+		}
+	}
+
+	return body, diags
+}
+
+// experimentModelFromAPI populates an experimentModel from a GrowthBook API Experiment.
+func experimentModelFromAPI(ctx context.Context, m *experimentModel, e *growthbookapi.Experiment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(e.ID)
+	m.TrackingKey = types.StringValue(e.TrackingKey)
+	m.Name = types.StringValue(e.Name)
+	m.Project = types.StringValue(e.Project)
+	m.Hypothesis = types.StringValue(e.Hypothesis)
+	m.Description = types.StringValue(e.Description)
+	m.Tags = stringsToList(ctx, e.Tags)
+	m.Owner = types.StringValue(e.Owner)
+	m.Archived = types.BoolValue(e.Archived)
+	m.Status = types.StringValue(e.Status)
+	m.HashAttribute = types.StringValue(e.HashAttribute)
+	m.DatasourceID = types.StringValue(e.Settings.DatasourceID)
+	m.AssignmentQueryID = types.StringValue(e.Settings.AssignmentQueryID)
+	m.Metrics = stringsToList(ctx, experimentMetricIDs(e.Settings.Goals))
+	m.SecondaryMetrics = stringsToList(ctx, experimentMetricIDs(e.Settings.SecondaryMetrics))
+	m.GuardrailMetrics = stringsToList(ctx, experimentMetricIDs(e.Settings.Guardrails))
+	m.DateCreated = types.StringValue(e.DateCreated)
+	m.DateUpdated = types.StringValue(e.DateUpdated)
+
+	m.Variations = make([]experimentVariationModel, len(e.Variations))
+	order := make(map[string]int, len(e.Variations))
+	for i, v := range e.Variations {
+		order[v.VariationID] = i
+		m.Variations[i] = experimentVariationModel{
+			ID:          types.StringValue(v.VariationID),
+			Key:         types.StringValue(v.Key),
+			Name:        types.StringValue(v.Name),
+			Description: types.StringValue(v.Description),
+		}
+	}
+
+	phases := make([]experimentPhaseModel, len(e.Phases))
+	for i, p := range e.Phases {
+		// traffic split is keyed by variation ID in responses, flatten it back to variation order
+		weights := make([]attr.Value, len(e.Variations))
+		for j := range weights {
+			weights[j] = types.Float64Value(0)
+		}
+		for _, s := range p.TrafficSplit {
+			if j, ok := order[s.VariationID]; ok {
+				weights[j] = types.Float64Value(s.Weight)
+			}
+		}
+		split, d := types.ListValue(types.Float64Type, weights)
+		diags.Append(d...)
+
+		phases[i] = experimentPhaseModel{
+			Name:              types.StringValue(p.Name),
+			DateStarted:       types.StringValue(p.DateStarted),
+			DateEnded:         types.StringValue(p.DateEnded),
+			ReasonForStopping: types.StringValue(p.ReasonForStopping),
+			Coverage:          types.Float64Value(p.Coverage),
+			TrafficSplit:      split,
+			Condition:         types.StringValue(p.TargetingCondition),
+		}
+	}
+	var d diag.Diagnostics
+	m.Phases, d = types.ListValueFrom(ctx, experimentPhaseObjectType(), phases)
+	diags.Append(d...)
+
+	return diags
+}
+
+func experimentMetricIDs(metrics []growthbookapi.ExperimentMetric) []string {
+	out := make([]string, len(metrics))
+	for i, m := range metrics {
+		out[i] = m.MetricID
+	}
+	return out
+}
@@ -0,0 +1,176 @@
+package internal_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccExperimentConfig(id, hypothesis string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_experiment" "test" {
+  tracking_key = "` + id + `"
+  name         = "` + id + `"
+  hypothesis   = "` + hypothesis + `"
+  variations = [
+    { key = "0", name = "Control" },
+    { key = "1", name = "Treatment" },
+  ]
+  phases = [{
+    name          = "Main"
+    coverage      = 0.5
+    traffic_split = [0.5, 0.5]
+  }]
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+  environments = {
+    (growthbook_environment.test.id) = {
+      enabled = true
+      rules = [{
+        type          = "experiment-ref"
+        enabled       = true
+        experiment_id = growthbook_experiment.test.id
+        variations = [
+          { value = "false", variation_id = growthbook_experiment.test.variations[0].id },
+          { value = "true", variation_id = growthbook_experiment.test.variations[1].id },
+        ]
+      }]
+    }
+  }
+}
+`
+}
+
+func TestAccGrowthBookExperiment_basic(t *testing.T) {
+	t.Parallel()
+	testAccSkipOnMock(t)
+
+	id := acctest.RandomWithPrefix("tf-acc-exp-")
+	envKey := "environments." + id + "-env"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentConfig(id, "Treatment converts better"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_experiment.test", "id", "exp_"),
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "tracking_key", id),
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "variations.#", "2"),
+					testCheckResourceAttrPrefix("growthbook_experiment.test", "variations.0.id", "var_"),
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "phases.0.coverage", "0.5"),
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "phases.0.traffic_split.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"growthbook_feature.test", envKey+".rules.0.experiment_id",
+						"growthbook_experiment.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"growthbook_feature.test", envKey+".rules.0.variations.1.variation_id",
+						"growthbook_experiment.test", "variations.1.id",
+					),
+				),
+			},
+			{
+				Config: testAccExperimentConfig(id, "Treatment converts much better"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "hypothesis", "Treatment converts much better"),
+				),
+			},
+		},
+	})
+}
+
+// Variation IDs follow the variation keys, so inserting a variation does not shift the IDs of the others.
+func TestAccGrowthBookExperiment_insertVariation(t *testing.T) {
+	t.Parallel()
+	testAccSkipOnMock(t)
+
+	id := acctest.RandomWithPrefix("tf-acc-exp-")
+	config := func(variations string) string {
+		return `
+resource "growthbook_experiment" "test" {
+  tracking_key = "` + id + `"
+  name         = "` + id + `"
+  variations   = [` + variations + `]
+}
+`
+	}
+
+	var treatmentID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`{ key = "0", name = "Control" }, { key = "1", name = "Treatment" }`),
+				Check: resource.TestCheckResourceAttrWith("growthbook_experiment.test", "variations.1.id",
+					func(value string) error {
+						treatmentID = value
+						return nil
+					}),
+			},
+			{
+				Config: config(`{ key = "0", name = "Control" }, { key = "2", name = "Other" }, ` +
+					`{ key = "1", name = "Treatment" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "variations.#", "3"),
+					resource.TestCheckResourceAttrWith("growthbook_experiment.test", "variations.2.id",
+						func(value string) error {
+							if value != treatmentID {
+								return fmt.Errorf("expected the treatment to keep ID %s, got %s", treatmentID, value)
+							}
+							return nil
+						}),
+				),
+			},
+		},
+	})
+}
+
+// Variations computed from values known after apply only are accepted, and get their IDs at apply time.
+func TestAccGrowthBookExperiment_unknownVariations(t *testing.T) {
+	t.Parallel()
+
+	id := acctest.RandomWithPrefix("tf-acc-exp-")
+	config := func(keys string) string {
+		return `
+resource "growthbook_experiment" "test" {
+  tracking_key = "` + id + `"
+  name         = "` + id + `"
+  variations   = [for key in ` + keys + ` : { key = key, name = "Variation ${key}" }]
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["0", "1"]`),
+			},
+			{
+				// the project is created in this step, so the list of keys is unknown until apply
+				Config: `
+resource "growthbook_project" "test" {
+  name = "` + id + `"
+}
+` + config(`(growthbook_project.test.id != "" ? ["0", "1", "2"] : [])`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_experiment.test", "variations.#", "3"),
+					resource.TestCheckResourceAttrSet("growthbook_experiment.test", "variations.2.id"),
+				),
+			},
+		},
+	})
+}
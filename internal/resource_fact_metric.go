@@ -0,0 +1,381 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &factMetricResource{}
+var _ resource.ResourceWithImportState = &factMetricResource{}
+var _ resource.ResourceWithIdentity = &factMetricResource{}
+
+func newFactMetricResource() resource.Resource {
+	return &factMetricResource{}
+}
+
+type factMetricResource struct {
+	clients *providerClients
+}
+
+type factMetricModel struct {
+	ID               types.String           `tfsdk:"id"`
+	Name             types.String           `tfsdk:"name"`
+	Description      types.String           `tfsdk:"description"`
+	Owner            types.String           `tfsdk:"owner"`
+	Projects         types.List             `tfsdk:"projects"`
+	Tags             types.List             `tfsdk:"tags"`
+	Datasource       types.String           `tfsdk:"datasource"`
+	MetricType       types.String           `tfsdk:"metric_type"`
+	Numerator        factMetricColumnModel  `tfsdk:"numerator"`
+	Denominator      *factMetricColumnModel `tfsdk:"denominator"`
+	Inverse          types.Bool             `tfsdk:"inverse"`
+	CappingType      types.String           `tfsdk:"capping_type"`
+	CappingValue     types.Float64          `tfsdk:"capping_value"`
+	WindowType       types.String           `tfsdk:"window_type"`
+	WindowDelayHours types.Float64          `tfsdk:"window_delay_hours"`
+	WindowValue      types.Float64          `tfsdk:"window_value"`
+	WindowUnit       types.String           `tfsdk:"window_unit"`
+	DateCreated      types.String           `tfsdk:"date_created"`
+	DateUpdated      types.String           `tfsdk:"date_updated"`
+	Organization     types.String           `tfsdk:"organization"`
+}
+
+// factMetricColumnModel maps the fact table column used as a numerator or denominator.
+type factMetricColumnModel struct {
+	FactTableID types.String `tfsdk:"fact_table_id"`
+	Column      types.String `tfsdk:"column"`
+	Aggregation types.String `tfsdk:"aggregation"`
+	Filters     types.List   `tfsdk:"filters"`
+}
+
+func factMetricColumnSchemaAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"fact_table_id": schema.StringAttribute{
+			Required: true,
+		},
+		"column": schema.StringAttribute{
+			Required: true,
+			Description: "Column to aggregate, or one of the special columns '$$distinctUsers' " +
+				"and '$$count'.",
+		},
+		"aggregation": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "One of 'sum', 'max' or 'count distinct'.",
+			Validators: []validator.String{
+				stringOneOf("sum", "max", "count distinct"),
+			},
+		},
+		"filters": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Fact table filter IDs.",
+		},
+	}
+}
+
+func (r *factMetricResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fact_metric"
+}
+
+func (r *factMetricResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"organization": organizationResourceAttribute(),
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"owner": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"projects": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"tags": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"datasource": schema.StringAttribute{
+			Computed:    true,
+			Description: "Data source of the numerator fact table.",
+		},
+		"metric_type": schema.StringAttribute{
+			Required:    true,
+			Description: "One of 'proportion', 'retention', 'mean', 'quantile' or 'ratio'.",
+			Validators: []validator.String{
+				stringOneOf("proportion", "retention", "mean", "quantile", "ratio"),
+			},
+		},
+		"numerator": schema.SingleNestedAttribute{
+			Required:   true,
+			Attributes: factMetricColumnSchemaAttrs(),
+		},
+		"denominator": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Only used by 'ratio' metrics.",
+			Attributes:  factMetricColumnSchemaAttrs(),
+		},
+		"inverse": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Set to true when a decrease of the metric is the desired outcome.",
+		},
+		"date_created": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"date_updated": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	for k, v := range metricSettingsSchemaAttrs() {
+		attrs[k] = v
+	}
+	resp.Schema = schema.Schema{Attributes: attrs}
+}
+
+func (r *factMetricResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func factMetricColumnToAPI(ctx context.Context, c factMetricColumnModel) (growthbookapi.FactMetricColumn, diag.Diagnostics) {
+	filters, diags := listToStrings(ctx, c.Filters)
+	return growthbookapi.FactMetricColumn{
+		FactTableID: c.FactTableID.ValueString(),
+		Column:      c.Column.ValueString(),
+		Aggregation: c.Aggregation.ValueString(),
+		Filters:     filters,
+	}, diags
+}
+
+func factMetricColumnFromAPI(ctx context.Context, c growthbookapi.FactMetricColumn) factMetricColumnModel {
+	return factMetricColumnModel{
+		FactTableID: types.StringValue(c.FactTableID),
+		Column:      types.StringValue(c.Column),
+		Aggregation: types.StringValue(c.Aggregation),
+		Filters:     stringsToList(ctx, c.Filters),
+	}
+}
+
+func factMetricFromPlan(ctx context.Context, data factMetricModel) (*growthbookapi.FactMetric, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := listToStrings(ctx, data.Tags)
+	diags.Append(d...)
+	projects, d := listToStrings(ctx, data.Projects)
+	diags.Append(d...)
+	numerator, d := factMetricColumnToAPI(ctx, data.Numerator)
+	diags.Append(d...)
+
+	m := &growthbookapi.FactMetric{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		Owner:           data.Owner.ValueString(),
+		Projects:        projects,
+		Tags:            tags,
+		MetricType:      data.MetricType.ValueString(),
+		Numerator:       numerator,
+		Inverse:         data.Inverse.ValueBool(),
+		CappingSettings: cappingToAPI(data.CappingType, data.CappingValue),
+		WindowSettings: windowToAPI(
+			data.WindowType, data.WindowDelayHours, data.WindowValue, data.WindowUnit,
+		),
+	}
+	if data.Denominator != nil {
+		denominator, d := factMetricColumnToAPI(ctx, *data.Denominator)
+		diags.Append(d...)
+		m.Denominator = &denominator
+	}
+	return m, diags
+}
+
+func factMetricToModel(ctx context.Context, m *growthbookapi.FactMetric) factMetricModel {
+	out := factMetricModel{
+		ID:          types.StringValue(m.ID),
+		Name:        types.StringValue(m.Name),
+		Description: types.StringValue(m.Description),
+		Owner:       types.StringValue(m.Owner),
+		Projects:    stringsToList(ctx, m.Projects),
+		Tags:        stringsToList(ctx, m.Tags),
+		Datasource:  types.StringValue(m.Datasource),
+		MetricType:  types.StringValue(m.MetricType),
+		Numerator:   factMetricColumnFromAPI(ctx, m.Numerator),
+		Inverse:     types.BoolValue(m.Inverse),
+		DateCreated: types.StringValue(m.DateCreated),
+		DateUpdated: types.StringValue(m.DateUpdated),
+	}
+	// the API may echo an empty denominator for non-ratio metrics
+	if m.Denominator != nil && m.MetricType == "ratio" {
+		denominator := factMetricColumnFromAPI(ctx, *m.Denominator)
+		out.Denominator = &denominator
+	}
+	out.CappingType, out.CappingValue = cappingFromAPI(m.CappingSettings)
+	out.WindowType, out.WindowDelayHours, out.WindowValue, out.WindowUnit = windowFromAPI(m.WindowSettings)
+	return out
+}
+
+func (r *factMetricResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data factMetricModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metric, diags := factMetricFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateFactMetric(ctx, metric)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating fact metric", err.Error())
+		return
+	}
+
+	result := factMetricToModel(ctx, created)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *factMetricResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data factMetricModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metric, err := client.GetFactMetric(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading fact metric", err.Error())
+		return
+	}
+
+	result := factMetricToModel(ctx, metric)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *factMetricResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data factMetricModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state factMetricModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metric, diags := factMetricFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateFactMetric(ctx, state.ID.ValueString(), metric)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating fact metric", err.Error())
+		return
+	}
+
+	result := factMetricToModel(ctx, updated)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *factMetricResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data factMetricModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteFactMetric(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting fact metric", err.Error())
+	}
+}
+
+func (r *factMetricResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *factMetricResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		items, err := client.ListFactMetrics(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(items, name,
+			func(v growthbookapi.FactMetric) string { return v.Name },
+			func(v growthbookapi.FactMetric) string { return v.ID })
+	})
+}
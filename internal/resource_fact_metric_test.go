@@ -0,0 +1,71 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFactMetricConfig(t *testing.T, name, metricType string) string {
+	t.Helper()
+
+	denominator := ""
+	if metricType == "ratio" {
+		denominator = `
+  denominator = {
+    fact_table_id = growthbook_fact_table.test.id
+    column        = "$$count"
+  }`
+	}
+	return testAccFactTableConfig(t, name, "[]") + `
+resource "growthbook_fact_metric" "test" {
+  name        = "` + name + `"
+  metric_type = "` + metricType + `"
+  numerator = {
+    fact_table_id = growthbook_fact_table.test.id
+    column        = "$$distinctUsers"
+  }` + denominator + `
+}
+data "growthbook_fact_metric" "by_name" {
+  name = growthbook_fact_metric.test.name
+}
+`
+}
+
+func TestAccGrowthBookFactMetric_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-fact-met-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFactMetricConfig(t, name, "proportion"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_fact_metric.test", "id", "fact__"),
+					resource.TestCheckResourceAttr("growthbook_fact_metric.test", "metric_type", "proportion"),
+					resource.TestCheckResourceAttrPair("growthbook_fact_metric.test", "numerator.fact_table_id",
+						"growthbook_fact_table.test", "id"),
+					resource.TestCheckNoResourceAttr("growthbook_fact_metric.test", "denominator"),
+					resource.TestCheckResourceAttrPair("data.growthbook_fact_metric.by_name", "id",
+						"growthbook_fact_metric.test", "id"),
+				),
+			},
+			{
+				Config: testAccFactMetricConfig(t, name, "ratio"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_fact_metric.test", "metric_type", "ratio"),
+					resource.TestCheckResourceAttr("growthbook_fact_metric.test", "denominator.column", "$$count"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_fact_metric.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,565 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &factTableResource{}
+var _ resource.ResourceWithImportState = &factTableResource{}
+var _ resource.ResourceWithIdentity = &factTableResource{}
+
+func newFactTableResource() resource.Resource {
+	return &factTableResource{}
+}
+
+type factTableResource struct {
+	clients *providerClients
+}
+
+type factTableModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Owner        types.String `tfsdk:"owner"`
+	Projects     types.List   `tfsdk:"projects"`
+	Tags         types.List   `tfsdk:"tags"`
+	Datasource   types.String `tfsdk:"datasource"`
+	UserIDTypes  types.List   `tfsdk:"user_id_types"`
+	SQL          types.String `tfsdk:"sql"`
+	EventName    types.String `tfsdk:"event_name"`
+	Columns      types.List   `tfsdk:"columns"`
+	Filters      types.List   `tfsdk:"filters"`
+	DateCreated  types.String `tfsdk:"date_created"`
+	DateUpdated  types.String `tfsdk:"date_updated"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+type factTableColumnModel struct {
+	Column       types.String `tfsdk:"column"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Datatype     types.String `tfsdk:"datatype"`
+	NumberFormat types.String `tfsdk:"number_format"`
+}
+
+type factTableFilterModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Value       types.String `tfsdk:"value"`
+}
+
+func factTableColumnObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"column":        types.StringType,
+		"name":          types.StringType,
+		"description":   types.StringType,
+		"datatype":      types.StringType,
+		"number_format": types.StringType,
+	}}
+}
+
+func factTableFilterObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"value":       types.StringType,
+	}}
+}
+
+func (r *factTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fact_table"
+}
+
+func (r *factTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"datasource": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the data source the SQL runs against.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id_types": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Identifier types returned by the SQL, as defined on the data source.",
+			},
+			"sql": schema.StringAttribute{
+				Required: true,
+			},
+			"event_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"columns": schema.ListNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Column metadata. Columns are detected by GrowthBook from the SQL, " +
+					"when set this list must describe every column.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"column": schema.StringAttribute{
+							Required: true,
+						},
+						"name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"datatype": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "One of 'number', 'string', 'date', 'boolean', 'json' or 'other'.",
+							Validators: []validator.String{
+								stringOneOf("number", "string", "date", "boolean", "json", "other"),
+							},
+						},
+						"number_format": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "One of 'currency', 'time:seconds' or 'memory:bytes', for number columns.",
+						},
+					},
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Reusable filters of the fact table, matched by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "SQL condition, e.g. \"country = 'US'\".",
+						},
+					},
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *factTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func factTableFromPlan(ctx context.Context, data factTableModel) (*growthbookapi.FactTable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projects, d := listToStrings(ctx, data.Projects)
+	diags.Append(d...)
+	tags, d := listToStrings(ctx, data.Tags)
+	diags.Append(d...)
+	userIDTypes, d := listToStrings(ctx, data.UserIDTypes)
+	diags.Append(d...)
+
+	t := &growthbookapi.FactTable{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Owner:       data.Owner.ValueString(),
+		Projects:    projects,
+		Tags:        tags,
+		Datasource:  data.Datasource.ValueString(),
+		UserIDTypes: userIDTypes,
+		SQL:         data.SQL.ValueString(),
+		EventName:   data.EventName.ValueString(),
+	}
+	if !data.Columns.IsNull() && !data.Columns.IsUnknown() {
+		var columns []factTableColumnModel
+		diags.Append(data.Columns.ElementsAs(ctx, &columns, false)...)
+		for _, c := range columns {
+			t.Columns = append(t.Columns, growthbookapi.FactTableColumn{
+				Column:       c.Column.ValueString(),
+				Name:         c.Name.ValueString(),
+				Description:  c.Description.ValueString(),
+				Datatype:     c.Datatype.ValueString(),
+				NumberFormat: c.NumberFormat.ValueString(),
+			})
+		}
+	}
+	return t, diags
+}
+
+// factTableModelFromAPI populates a factTableModel from a GrowthBook API FactTable and its filters.
+func factTableModelFromAPI(
+	ctx context.Context,
+	m *factTableModel,
+	t *growthbookapi.FactTable,
+	filters []growthbookapi.FactTableFilter,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(t.ID)
+	m.Name = types.StringValue(t.Name)
+	m.Description = types.StringValue(t.Description)
+	m.Owner = types.StringValue(t.Owner)
+	m.Projects = stringsToList(ctx, t.Projects)
+	m.Tags = stringsToList(ctx, t.Tags)
+	m.Datasource = types.StringValue(t.Datasource)
+	m.UserIDTypes = stringsToList(ctx, t.UserIDTypes)
+	m.SQL = types.StringValue(t.SQL)
+	m.EventName = types.StringValue(t.EventName)
+	m.DateCreated = types.StringValue(t.DateCreated)
+	m.DateUpdated = types.StringValue(t.DateUpdated)
+
+	columns := []factTableColumnModel{}
+	for _, c := range t.Columns {
+		if c.Deleted {
+			continue
+		}
+		columns = append(columns, factTableColumnModel{
+			Column:       types.StringValue(c.Column),
+			Name:         types.StringValue(c.Name),
+			Description:  types.StringValue(c.Description),
+			Datatype:     types.StringValue(c.Datatype),
+			NumberFormat: types.StringValue(c.NumberFormat),
+		})
+	}
+	var d diag.Diagnostics
+	m.Columns, d = types.ListValueFrom(ctx, factTableColumnObjectType(), columns)
+	diags.Append(d...)
+
+	filterModels := make([]factTableFilterModel, len(filters))
+	for i, f := range filters {
+		filterModels[i] = factTableFilterModel{
+			ID:          types.StringValue(f.ID),
+			Name:        types.StringValue(f.Name),
+			Description: types.StringValue(f.Description),
+			Value:       types.StringValue(f.Value),
+		}
+	}
+	m.Filters, d = types.ListValueFrom(ctx, factTableFilterObjectType(), filterModels)
+	diags.Append(d...)
+
+	return diags
+}
+
+// syncFactTableFilters makes the filters of a fact table match the planned ones, matching them by name.
+// It returns the resulting filters in plan order.
+func syncFactTableFilters(
+	ctx context.Context,
+	client *growthbookapi.Client,
+	factTableID string,
+	planned types.List,
+) ([]growthbookapi.FactTableFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existing, err := client.ListFactTableFilters(ctx, factTableID)
+	if err != nil {
+		diags.AddError("Error listing fact table filters", err.Error())
+		return nil, diags
+	}
+	// filters are not managed when the attribute is left unset
+	if planned.IsNull() || planned.IsUnknown() {
+		return existing, diags
+	}
+
+	var models []factTableFilterModel
+	diags.Append(planned.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	byName := make(map[string]growthbookapi.FactTableFilter, len(existing))
+	for _, f := range existing {
+		byName[f.Name] = f
+	}
+
+	out := make([]growthbookapi.FactTableFilter, 0, len(models))
+	for _, m := range models {
+		want := growthbookapi.FactTableFilter{
+			Name:        m.Name.ValueString(),
+			Description: m.Description.ValueString(),
+			Value:       m.Value.ValueString(),
+		}
+		current, found := byName[want.Name]
+		delete(byName, want.Name)
+		switch {
+		case !found:
+			created, err := client.CreateFactTableFilter(ctx, factTableID, &want)
+			if err != nil {
+				diags.AddError("Error creating fact table filter "+want.Name, err.Error())
+				return nil, diags
+			}
+			out = append(out, *created)
+		case current.Description != want.Description || current.Value != want.Value:
+			updated, err := client.UpdateFactTableFilter(ctx, factTableID, current.ID, &want)
+			if err != nil {
+				diags.AddError("Error updating fact table filter "+want.Name, err.Error())
+				return nil, diags
+			}
+			out = append(out, *updated)
+		default:
+			out = append(out, current)
+		}
+	}
+
+	for _, f := range byName {
+		err := client.DeleteFactTableFilter(ctx, factTableID, f.ID)
+		if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+			diags.AddError("Error deleting fact table filter "+f.Name, err.Error())
+			return nil, diags
+		}
+	}
+
+	return out, diags
+}
+
+// sortFactTableFilters orders filters like the ones previously stored in state,
+// so that reads do not report a diff when the API returns them in a different order.
+func sortFactTableFilters(ctx context.Context, filters []growthbookapi.FactTableFilter, prior types.List) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return
+	}
+	var models []factTableFilterModel
+	if diags := prior.ElementsAs(ctx, &models, false); diags.HasError() {
+		return
+	}
+	position := make(map[string]int, len(models))
+	for i, m := range models {
+		position[m.Name.ValueString()] = i
+	}
+	slices.SortStableFunc(filters, func(a, b growthbookapi.FactTableFilter) int {
+		pa, okA := position[a.Name]
+		pb, okB := position[b.Name]
+		switch {
+		case okA && okB:
+			return pa - pb
+		case okA:
+			return -1
+		case okB:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+func (r *factTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data factTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, diags := factTableFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateFactTable(ctx, table)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating fact table", err.Error())
+		return
+	}
+
+	filters, diags := syncFactTableFilters(ctx, client, created.ID, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// keep the table in state so it is not orphaned, it will be replaced on the next apply
+		data.ID = types.StringValue(created.ID)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+		return
+	}
+
+	resp.Diagnostics.Append(factTableModelFromAPI(ctx, &data, created, filters)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *factTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data factTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := client.GetFactTable(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading fact table", err.Error())
+		return
+	}
+
+	filters, err := client.ListFactTableFilters(ctx, table.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing fact table filters", err.Error())
+		return
+	}
+	sortFactTableFilters(ctx, filters, data.Filters)
+
+	resp.Diagnostics.Append(factTableModelFromAPI(ctx, &data, table, filters)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *factTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data factTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state factTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, diags := factTableFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateFactTable(ctx, state.ID.ValueString(), table)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating fact table", err.Error())
+		return
+	}
+
+	filters, diags := syncFactTableFilters(ctx, client, updated.ID, data.Filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(factTableModelFromAPI(ctx, &data, updated, filters)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *factTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data factTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteFactTable(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting fact table", err.Error())
+	}
+}
+
+func (r *factTableResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *factTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		items, err := client.ListFactTables(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(items, name,
+			func(v growthbookapi.FactTable) string { return v.Name },
+			func(v growthbookapi.FactTable) string { return v.ID })
+	})
+}
@@ -0,0 +1,64 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFactTableConfig(t *testing.T, name, filters string) string {
+	t.Helper()
+
+	return testAccDataSourceConfig(t, name, "") + `
+resource "growthbook_fact_table" "test" {
+  name          = "` + name + `"
+  datasource    = growthbook_data_source.test.id
+  user_id_types = ["user_id"]
+  sql           = "SELECT user_id, timestamp, amount, country FROM purchases"
+  filters       = ` + filters + `
+}
+`
+}
+
+func TestAccGrowthBookFactTable_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-ft-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFactTableConfig(t, name, `[
+    { name = "US", value = "country = 'US'" },
+    { name = "FR", value = "country = 'FR'" },
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_fact_table.test", "id", "ftb_"),
+					resource.TestCheckResourceAttrPair("growthbook_fact_table.test", "datasource",
+						"growthbook_data_source.test", "id"),
+					resource.TestCheckResourceAttr("growthbook_fact_table.test", "filters.#", "2"),
+					resource.TestCheckResourceAttr("growthbook_fact_table.test", "filters.0.name", "US"),
+					resource.TestCheckResourceAttrSet("growthbook_fact_table.test", "filters.0.id"),
+				),
+			},
+			{
+				Config: testAccFactTableConfig(t, name, `[
+    { name = "FR", value = "country = 'FR' AND amount > 0" },
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_fact_table.test", "filters.#", "1"),
+					resource.TestCheckResourceAttr("growthbook_fact_table.test", "filters.0.value",
+						"country = 'FR' AND amount > 0"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_fact_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
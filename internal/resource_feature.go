@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-growthbook/internal/growthbookapi"
@@ -20,13 +22,15 @@ import (
 
 var _ resource.Resource = &featureResource{}
 var _ resource.ResourceWithImportState = &featureResource{}
+var _ resource.ResourceWithIdentity = &featureResource{}
+var _ resource.ResourceWithValidateConfig = &featureResource{}
 
 func newFeatureResource() resource.Resource {
 	return &featureResource{}
 }
 
 type featureResource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 // featureEnvironmentModel maps a single GrowthBook feature environment.
@@ -38,17 +42,18 @@ type featureEnvironmentModel struct {
 
 // featureRuleModel maps a single targeting rule (force / rollout / experiment-ref).
 type featureRuleModel struct {
-	ID            types.String            `tfsdk:"id"`
-	Type          types.String            `tfsdk:"type"`
-	Enabled       types.Bool              `tfsdk:"enabled"`
-	Description   types.String            `tfsdk:"description"`
-	Condition     types.String            `tfsdk:"condition"`
-	Value         types.String            `tfsdk:"value"`
-	Coverage      types.Float64           `tfsdk:"coverage"`
-	HashAttribute types.String            `tfsdk:"hash_attribute"`
-	ExperimentID  types.String            `tfsdk:"experiment_id"`
-	Variations    []featureVariationModel `tfsdk:"variations"`
-	Prerequisites []featurePrereqModel    `tfsdk:"prerequisites"`
+	ID                  types.String                      `tfsdk:"id"`
+	Type                types.String                      `tfsdk:"type"`
+	Enabled             types.Bool                        `tfsdk:"enabled"`
+	Description         types.String                      `tfsdk:"description"`
+	Condition           types.String                      `tfsdk:"condition"`
+	Value               types.String                      `tfsdk:"value"`
+	Coverage            types.Float64                     `tfsdk:"coverage"`
+	HashAttribute       types.String                      `tfsdk:"hash_attribute"`
+	ExperimentID        types.String                      `tfsdk:"experiment_id"`
+	Variations          []featureVariationModel           `tfsdk:"variations"`
+	SavedGroupTargeting []featureSavedGroupTargetingModel `tfsdk:"saved_group_targeting"`
+	Prerequisites       []featurePrereqModel              `tfsdk:"prerequisites"`
 }
 
 // featureVariationModel maps a single experiment-ref variation.
@@ -57,6 +62,12 @@ type featureVariationModel struct {
 	VariationID types.String `tfsdk:"variation_id"`
 }
 
+// featureSavedGroupTargetingModel maps a rule saved group targeting entry.
+type featureSavedGroupTargetingModel struct {
+	MatchType   types.String   `tfsdk:"match_type"`
+	SavedGroups []types.String `tfsdk:"saved_groups"`
+}
+
 // featurePrereqModel maps a rule prerequisite.
 type featurePrereqModel struct {
 	ID        types.String `tfsdk:"id"`
@@ -75,8 +86,14 @@ type featureModel struct {
 	Tags          types.List   `tfsdk:"tags"`
 	Environments  types.Map    `tfsdk:"environments"`
 	Prerequisites types.List   `tfsdk:"prerequisites"`
+	PublishMode   types.String `tfsdk:"publish_mode"`
+	DraftVersion  types.Int64  `tfsdk:"draft_version"`
+	Organization  types.String `tfsdk:"organization"`
 }
 
+// featureDraftComment identifies the draft revisions staged by the provider.
+const featureDraftComment = "Staged by Terraform"
+
 // Attribute type definitions for nested objects (used by types.MapValueFrom).
 
 func featurePrereqObjectType() types.ObjectType {
@@ -93,19 +110,27 @@ func featureVariationObjectType() types.ObjectType {
 	}}
 }
 
+func featureSavedGroupTargetingObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"match_type":   types.StringType,
+		"saved_groups": types.ListType{ElemType: types.StringType},
+	}}
+}
+
 func featureRuleObjectType() types.ObjectType {
 	return types.ObjectType{AttrTypes: map[string]attr.Type{
-		"id":             types.StringType,
-		"type":           types.StringType,
-		"enabled":        types.BoolType,
-		"description":    types.StringType,
-		"condition":      types.StringType,
-		"value":          types.StringType,
-		"coverage":       types.Float64Type,
-		"hash_attribute": types.StringType,
-		"experiment_id":  types.StringType,
-		"variations":     types.ListType{ElemType: featureVariationObjectType()},
-		"prerequisites":  types.ListType{ElemType: featurePrereqObjectType()},
+		"id":                    types.StringType,
+		"type":                  types.StringType,
+		"enabled":               types.BoolType,
+		"description":           types.StringType,
+		"condition":             types.StringType,
+		"value":                 types.StringType,
+		"coverage":              types.Float64Type,
+		"hash_attribute":        types.StringType,
+		"experiment_id":         types.StringType,
+		"variations":            types.ListType{ElemType: featureVariationObjectType()},
+		"saved_group_targeting": types.ListType{ElemType: featureSavedGroupTargetingObjectType()},
+		"prerequisites":         types.ListType{ElemType: featurePrereqObjectType()},
 	}}
 }
 
@@ -130,89 +155,121 @@ func featureEnvironmentSchemaAttr() schema.MapNestedAttribute {
 					Optional: true,
 					Computed: true,
 				},
-				"rules": schema.ListNestedAttribute{
+				"rules": featureRulesSchemaAttr(),
+			},
+		},
+	}
+}
+
+// featureRulesSchemaAttr is the schema of the rules of a feature environment.
+func featureRulesSchemaAttr() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional: true,
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"type": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"enabled": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"description": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				"condition": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "JSON targeting condition, see the provider::growthbook::condition function.",
+					Validators: []validator.String{
+						validCondition(),
+					},
+				},
+				"value": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"coverage": schema.Float64Attribute{
+					Optional: true,
+					Computed: true,
+					Default:  float64default.StaticFloat64(1.0),
+				},
+				"hash_attribute": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				"experiment_id": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				"variations": schema.ListNestedAttribute{
 					Optional: true,
 					Computed: true,
 					NestedObject: schema.NestedAttributeObject{
 						Attributes: map[string]schema.Attribute{
-							"id": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-							},
-							"type": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-							},
-							"enabled": schema.BoolAttribute{
-								Optional: true,
-								Computed: true,
-							},
-							"description": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  stringdefault.StaticString(""),
-							},
-							"condition": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-							},
 							"value": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
+								Required: true,
 							},
-							"coverage": schema.Float64Attribute{
-								Optional: true,
-								Computed: true,
-								Default:  float64default.StaticFloat64(1.0),
+							"variation_id": schema.StringAttribute{
+								Required: true,
 							},
-							"hash_attribute": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  stringdefault.StaticString(""),
+						},
+					},
+					Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+						"value":        types.StringType,
+						"variation_id": types.StringType,
+					}}, []attr.Value{})),
+				},
+				"saved_group_targeting": schema.ListNestedAttribute{
+					Optional: true,
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"match_type": schema.StringAttribute{
+								Required:    true,
+								Description: "One of 'any', 'all' or 'none'.",
+								Validators: []validator.String{
+									stringOneOf("any", "all", "none"),
+								},
 							},
-							"experiment_id": schema.StringAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  stringdefault.StaticString(""),
+							"saved_groups": schema.ListAttribute{
+								ElementType: types.StringType,
+								Required:    true,
+								Description: "Saved group IDs.",
 							},
-							"variations": schema.ListNestedAttribute{
-								Optional: true,
-								Computed: true,
-								NestedObject: schema.NestedAttributeObject{
-									Attributes: map[string]schema.Attribute{
-										"value": schema.StringAttribute{
-											Required: true,
-										},
-										"variation_id": schema.StringAttribute{
-											Required: true,
-										},
-									},
-								},
-								Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
-									"value":        types.StringType,
-									"variation_id": types.StringType,
-								}}, []attr.Value{})),
+						},
+					},
+					Default: listdefault.StaticValue(
+						types.ListValueMust(featureSavedGroupTargetingObjectType(), []attr.Value{}),
+					),
+				},
+				"prerequisites": schema.ListNestedAttribute{
+					Optional: true,
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Required: true,
 							},
-							"prerequisites": schema.ListNestedAttribute{
-								Optional: true,
-								Computed: true,
-								NestedObject: schema.NestedAttributeObject{
-									Attributes: map[string]schema.Attribute{
-										"id": schema.StringAttribute{
-											Required: true,
-										},
-										"condition": schema.StringAttribute{
-											Required: true,
-										},
-									},
-								},
-								Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
-									"id":        types.StringType,
-									"condition": types.StringType,
-								}}, []attr.Value{})),
+							"condition": schema.StringAttribute{
+								Required: true,
 							},
 						},
 					},
+					Default: listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+						"id":        types.StringType,
+						"condition": types.StringType,
+					}}, []attr.Value{})),
 				},
 			},
 		},
@@ -226,6 +283,7 @@ func (r *featureResource) Metadata(_ context.Context, req resource.MetadataReque
 func (r *featureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -257,7 +315,8 @@ func (r *featureResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"default_value": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "Default value, checked against value_type. Number and JSON values are compared by value.",
 			},
 			"tags": schema.ListAttribute{
 				ElementType: types.StringType,
@@ -270,6 +329,18 @@ func (r *featureResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 			},
+			"publish_mode": schema.StringAttribute{
+				Optional: true,
+				Description: "How changes of the default value and environments are published: 'live' writes them " +
+					"directly, 'draft' stages them as a draft revision awaiting review. Defaults to the provider publish_mode.",
+				Validators: []validator.String{
+					stringOneOf(growthbookapi.PublishModeLive, growthbookapi.PublishModeDraft),
+				},
+			},
+			"draft_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Version of the draft revision staged by Terraform and awaiting review, if any.",
+			},
 		},
 	}
 }
@@ -278,12 +349,62 @@ func (r *featureResource) Configure(_ context.Context, req resource.ConfigureReq
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	r.client = client
+	r.clients = clients
+}
+
+// ValidateConfig checks the default values and rule values against value_type.
+// Environments and rules are walked as raw values since parts of them may still be unknown.
+func (r *featureResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data featureModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ValueType.IsUnknown() || data.ValueType.IsNull() {
+		return
+	}
+	valueType := data.ValueType.ValueString()
+
+	check := func(p path.Path, v attr.Value) {
+		s, ok := v.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return
+		}
+		if err := validateFeatureValue(valueType, s.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(p, "Invalid feature value", err.Error())
+		}
+	}
+
+	check(path.Root("default_value"), data.DefaultValue)
+	if data.Environments.IsNull() || data.Environments.IsUnknown() {
+		return
+	}
+	for name, env := range data.Environments.Elements() {
+		envObj, ok := env.(types.Object)
+		if !ok || envObj.IsNull() || envObj.IsUnknown() {
+			continue
+		}
+		envPath := path.Root("environments").AtMapKey(name)
+		check(envPath.AtName("default_value"), envObj.Attributes()["default_value"])
+
+		rules, ok := envObj.Attributes()["rules"].(types.List)
+		if !ok || rules.IsNull() || rules.IsUnknown() {
+			continue
+		}
+		for i, rule := range rules.Elements() {
+			ruleObj, ok := rule.(types.Object)
+			if !ok || ruleObj.IsNull() || ruleObj.IsUnknown() {
+				continue
+			}
+			check(envPath.AtName("rules").AtListIndex(i).AtName("value"), ruleObj.Attributes()["value"])
+		}
+	}
 }
 
 func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -293,6 +414,12 @@ func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tags := []string{}
 	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
 		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
@@ -331,14 +458,18 @@ func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest
 		Environments:  apiEnvs,
 	}
 
-	created, err := r.client.CreateFeature(ctx, feature)
+	// features are always created live, revisions only exist for existing features
+	created, err := client.CreateFeature(ctx, feature)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating feature", err.Error())
 		return
 	}
 
+	plan := data
 	resp.Diagnostics.Append(featureModelFromAPI(ctx, &data, created)...)
+	resp.Diagnostics.Append(keepFeatureValues(ctx, plan, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *featureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -348,14 +479,27 @@ func (r *featureResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	feature, err := r.client.GetFeature(ctx, data.ID.ValueString())
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := data
+	feature, err := client.GetFeature(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading feature", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(featureModelFromAPI(ctx, &data, feature)...)
+	resp.Diagnostics.Append(r.featureModelWithDraft(ctx, client, &data, feature)...)
+	resp.Diagnostics.Append(keepFeatureValues(ctx, state, &data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -365,6 +509,12 @@ func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state featureModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -408,15 +558,127 @@ func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest
 		Environments:  apiEnvs,
 	}
 
-	updated, err := r.client.UpdateFeature(ctx, state.ID.ValueString(), feature)
+	if r.publishMode(client, data) == growthbookapi.PublishModeDraft {
+		// the default value and environments go through a draft revision, the rest is written live
+		revision := &growthbookapi.FeatureRevision{
+			Comment:      featureDraftComment,
+			DefaultValue: feature.DefaultValue,
+			Environments: make(map[string]growthbookapi.FeatureDraft, len(apiEnvs)),
+		}
+		for name, env := range apiEnvs {
+			revision.Environments[name] = growthbookapi.FeatureDraft{Enabled: env.Enabled, Rules: env.Rules}
+		}
+		feature.DefaultValue = ""
+		feature.Environments = nil
+
+		// environments left out of the configuration are unknown in the plan, and are not changed
+		envsChanged := !data.Environments.IsUnknown() && !data.Environments.Equal(state.Environments)
+		if !data.DefaultValue.Equal(state.DefaultValue) || envsChanged {
+			resp.Diagnostics.Append(r.stageDraft(ctx, client, state.ID.ValueString(), revision)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	updated, err := client.UpdateFeature(ctx, state.ID.ValueString(), feature)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating feature", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(featureModelFromAPI(ctx, &data, updated)...)
+	plan := data
+	resp.Diagnostics.Append(r.featureModelWithDraft(ctx, client, &data, updated)...)
+	resp.Diagnostics.Append(keepFeatureValues(ctx, plan, &data)...)
 	data.ID = state.ID // preserve original ID in case API returns a different casing
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+// publishMode returns the publish mode of the feature, falling back to the provider one.
+func (r *featureResource) publishMode(client *growthbookapi.Client, data featureModel) string {
+	if !data.PublishMode.IsNull() && !data.PublishMode.IsUnknown() {
+		return data.PublishMode.ValueString()
+	}
+	return client.PublishMode
+}
+
+// stageDraft replaces the open draft revisions previously staged by Terraform with a new one.
+func (r *featureResource) stageDraft(
+	ctx context.Context,
+	client *growthbookapi.Client,
+	featureID string,
+	revision *growthbookapi.FeatureRevision,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	revisions, err := client.ListFeatureRevisions(ctx, featureID)
+	if err != nil {
+		diags.AddError("Error listing feature revisions", err.Error())
+		return diags
+	}
+	for _, rev := range revisions {
+		if rev.Open() && rev.Comment == featureDraftComment {
+			if _, err := client.DiscardFeatureRevision(ctx, featureID, rev.Version); err != nil {
+				diags.AddError("Error discarding feature revision", err.Error())
+				return diags
+			}
+		}
+	}
+	if _, err := client.CreateFeatureRevision(ctx, featureID, revision); err != nil {
+		diags.AddError("Error creating feature revision", err.Error())
+	}
+	return diags
+}
+
+// featureModelWithDraft populates m from the live feature. In draft mode, the default value and environments of
+// the open draft staged by Terraform are shown instead, so that staged changes do not show up as a diff.
+func (r *featureResource) featureModelWithDraft(
+	ctx context.Context,
+	client *growthbookapi.Client,
+	m *featureModel,
+	f *growthbookapi.Feature,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.publishMode(client, *m) == growthbookapi.PublishModeDraft {
+		revisions, err := client.ListFeatureRevisions(ctx, f.ID)
+		if err != nil {
+			diags.AddError("Error listing feature revisions", err.Error())
+			return diags
+		}
+		var draft *growthbookapi.FeatureRevision
+		for i, rev := range revisions {
+			if rev.Open() && rev.Comment == featureDraftComment && (draft == nil || rev.Version > draft.Version) {
+				draft = &revisions[i]
+			}
+		}
+		if draft != nil {
+			diags.Append(featureModelFromAPI(ctx, m, featureWithRevision(f, draft))...)
+			m.DraftVersion = types.Int64Value(int64(draft.Version))
+			return diags
+		}
+	}
+
+	diags.Append(featureModelFromAPI(ctx, m, f)...)
+	return diags
+}
+
+// featureWithRevision returns a copy of f with the default value and environments of a revision.
+func featureWithRevision(f *growthbookapi.Feature, rev *growthbookapi.FeatureRevision) *growthbookapi.Feature {
+	out := *f
+	out.DefaultValue = rev.DefaultValue
+	out.Environments = make(map[string]growthbookapi.FeatureEnvironmentConfig, len(f.Environments))
+	for name, env := range f.Environments {
+		out.Environments[name] = env
+	}
+	for name, draft := range rev.Environments {
+		env := out.Environments[name]
+		env.Enabled = draft.Enabled
+		env.Rules = draft.Rules
+		out.Environments[name] = env
+	}
+	return &out
 }
 
 func (r *featureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -426,13 +688,30 @@ func (r *featureResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if err := r.client.DeleteFeature(ctx, data.ID.ValueString()); err != nil {
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteFeature(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
 		resp.Diagnostics.AddError("Error deleting feature", err.Error())
 	}
 }
 
+func (r *featureResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
 func (r *featureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		f, err := client.FindFeatureByName(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		return f.ID, nil
+	})
 }
 
 // featureModelFromAPI populates a featureModel from a GrowthBook API Feature.
@@ -449,6 +728,7 @@ func featureModelFromAPI(ctx context.Context, m *featureModel, f *growthbookapi.
 	m.DefaultValue = types.StringValue(f.DefaultValue)
 	m.Tags = stringsToList(ctx, f.Tags)
 	m.Prerequisites = stringsToList(ctx, f.Prerequisites)
+	m.DraftVersion = types.Int64Null()
 
 	envsMap := envsFromAPI(f.Environments)
 	var d diag.Diagnostics
@@ -458,6 +738,38 @@ func featureModelFromAPI(ctx context.Context, m *featureModel, f *growthbookapi.
 	return diags
 }
 
+// keepFeatureValues keeps the default values and rule values of prior in m where they serve the same value, see
+// keepFeatureValue.
+func keepFeatureValues(ctx context.Context, prior featureModel, m *featureModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valueType := m.ValueType.ValueString()
+	m.DefaultValue = keepFeatureValue(valueType, prior.DefaultValue, m.DefaultValue)
+	if prior.Environments.IsNull() || prior.Environments.IsUnknown() || m.Environments.IsNull() {
+		return diags
+	}
+
+	var priorEnvs, envs map[string]featureEnvironmentModel
+	diags.Append(prior.Environments.ElementsAs(ctx, &priorEnvs, false)...)
+	diags.Append(m.Environments.ElementsAs(ctx, &envs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	for name, env := range envs {
+		priorEnv, ok := priorEnvs[name]
+		if !ok {
+			continue
+		}
+		env.DefaultValue = keepFeatureValue(valueType, priorEnv.DefaultValue, env.DefaultValue)
+		keepFeatureRuleValues(valueType, priorEnv.Rules, env.Rules)
+		envs[name] = env
+	}
+	var d diag.Diagnostics
+	m.Environments, d = types.MapValueFrom(ctx, featureEnvObjectType(), envs)
+	diags.Append(d...)
+	return diags
+}
+
 // envsFromAPI converts API environments to Terraform model environments.
 func envsFromAPI(envs map[string]growthbookapi.FeatureEnvironmentConfig) map[string]featureEnvironmentModel {
 	if envs == nil {
@@ -478,16 +790,17 @@ func rulesFromAPI(rules []growthbookapi.FeatureRule) []featureRuleModel {
 	out := make([]featureRuleModel, len(rules))
 	for i, r := range rules {
 		rm := featureRuleModel{
-			ID:            types.StringValue(r.ID),
-			Type:          types.StringValue(r.Type),
-			Enabled:       types.BoolValue(r.Enabled),
-			Description:   types.StringValue(r.Description),
-			Condition:     types.StringValue(r.Condition),
-			Value:         types.StringValue(r.Value),
-			HashAttribute: types.StringValue(r.HashAttribute),
-			ExperimentID:  types.StringValue(r.ExperimentID),
-			Variations:    variationsFromAPI(r.Variations),
-			Prerequisites: rulePrereqsFromAPI(r.Prerequisites),
+			ID:                  types.StringValue(r.ID),
+			Type:                types.StringValue(r.Type),
+			Enabled:             types.BoolValue(r.Enabled),
+			Description:         types.StringValue(r.Description),
+			Condition:           types.StringValue(r.Condition),
+			Value:               types.StringValue(r.Value),
+			HashAttribute:       types.StringValue(r.HashAttribute),
+			ExperimentID:        types.StringValue(r.ExperimentID),
+			Variations:          variationsFromAPI(r.Variations),
+			SavedGroupTargeting: savedGroupTargetingFromAPI(r.SavedGroupTargeting),
+			Prerequisites:       rulePrereqsFromAPI(r.Prerequisites),
 		}
 		if r.Coverage != nil {
 			rm.Coverage = types.Float64Value(*r.Coverage)
@@ -510,6 +823,17 @@ func variationsFromAPI(vars []growthbookapi.FeatureVariation) []featureVariation
 	return out
 }
 
+func savedGroupTargetingFromAPI(targets []growthbookapi.FeatureSavedGroupTargeting) []featureSavedGroupTargetingModel {
+	out := make([]featureSavedGroupTargetingModel, len(targets))
+	for i, t := range targets {
+		out[i] = featureSavedGroupTargetingModel{
+			MatchType:   types.StringValue(t.MatchType),
+			SavedGroups: stringsToValues(t.SavedGroups),
+		}
+	}
+	return out
+}
+
 func rulePrereqsFromAPI(prereqs []growthbookapi.FeaturePrerequisite) []featurePrereqModel {
 	out := make([]featurePrereqModel, len(prereqs))
 	for i, p := range prereqs {
@@ -541,16 +865,17 @@ func rulesToAPI(rules []featureRuleModel) []growthbookapi.FeatureRule {
 	out := make([]growthbookapi.FeatureRule, len(rules))
 	for i, r := range rules {
 		ar := growthbookapi.FeatureRule{
-			ID:            r.ID.ValueString(),
-			Type:          r.Type.ValueString(),
-			Enabled:       r.Enabled.ValueBool(),
-			Description:   r.Description.ValueString(),
-			Condition:     r.Condition.ValueString(),
-			Value:         r.Value.ValueString(),
-			HashAttribute: r.HashAttribute.ValueString(),
-			ExperimentID:  r.ExperimentID.ValueString(),
-			Variations:    variationsToAPI(r.Variations),
-			Prerequisites: rulePrereqsToAPI(r.Prerequisites),
+			ID:                  r.ID.ValueString(),
+			Type:                r.Type.ValueString(),
+			Enabled:             r.Enabled.ValueBool(),
+			Description:         r.Description.ValueString(),
+			Condition:           r.Condition.ValueString(),
+			Value:               r.Value.ValueString(),
+			HashAttribute:       r.HashAttribute.ValueString(),
+			ExperimentID:        r.ExperimentID.ValueString(),
+			Variations:          variationsToAPI(r.Variations),
+			SavedGroupTargeting: savedGroupTargetingToAPI(r.SavedGroupTargeting),
+			Prerequisites:       rulePrereqsToAPI(r.Prerequisites),
 		}
 		if !r.Coverage.IsNull() && !r.Coverage.IsUnknown() {
 			v := r.Coverage.ValueFloat64()
@@ -572,6 +897,17 @@ func variationsToAPI(vars []featureVariationModel) []growthbookapi.FeatureVariat
 	return out
 }
 
+func savedGroupTargetingToAPI(targets []featureSavedGroupTargetingModel) []growthbookapi.FeatureSavedGroupTargeting {
+	out := make([]growthbookapi.FeatureSavedGroupTargeting, len(targets))
+	for i, t := range targets {
+		out[i] = growthbookapi.FeatureSavedGroupTargeting{
+			MatchType:   t.MatchType.ValueString(),
+			SavedGroups: valuesToStrings(t.SavedGroups),
+		}
+	}
+	return out
+}
+
 func rulePrereqsToAPI(prereqs []featurePrereqModel) []growthbookapi.FeaturePrerequisite {
 	out := make([]growthbookapi.FeaturePrerequisite, len(prereqs))
 	for i, p := range prereqs {
@@ -582,3 +918,21 @@ func rulePrereqsToAPI(prereqs []featurePrereqModel) []growthbookapi.FeaturePrere
 	}
 	return out
 }
+
+// stringsToValues converts a []string to a slice of types.String values.
+func stringsToValues(ss []string) []types.String {
+	out := make([]types.String, len(ss))
+	for i, s := range ss {
+		out[i] = types.StringValue(s)
+	}
+	return out
+}
+
+// valuesToStrings converts a slice of types.String values to a []string.
+func valuesToStrings(vs []types.String) []string {
+	out := make([]string, len(vs))
+	for i, v := range vs {
+		out[i] = v.ValueString()
+	}
+	return out
+}
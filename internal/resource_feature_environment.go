@@ -0,0 +1,344 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &featureEnvironmentResource{}
+var _ resource.ResourceWithImportState = &featureEnvironmentResource{}
+var _ resource.ResourceWithIdentity = &featureEnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &featureEnvironmentResource{}
+
+func newFeatureEnvironmentResource() resource.Resource {
+	return &featureEnvironmentResource{}
+}
+
+// featureEnvironmentResource manages a single environment of a feature, so that its rules can be owned
+// separately from the feature definition.
+type featureEnvironmentResource struct {
+	clients *providerClients
+}
+
+type featureEnvironmentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	FeatureID    types.String `tfsdk:"feature_id"`
+	Environment  types.String `tfsdk:"environment"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Rules        types.List   `tfsdk:"rules"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+func (r *featureEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_environment"
+}
+
+func (r *featureEnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	rules := featureRulesSchemaAttr()
+	rules.Description = "Rules of the environment, in evaluation order."
+	rules.Default = listdefault.StaticValue(types.ListValueMust(featureRuleObjectType(), []attr.Value{}))
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the configuration of one environment of a feature, leaving its other environments " +
+			"untouched. Do not also set this environment in the environments of the growthbook_feature resource.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Feature ID and environment, separated by a slash.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"feature_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"default_value": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Value served in this environment when no rule matches, checked against the feature value_type.",
+			},
+			"rules": rules,
+		},
+	}
+}
+
+func (r *featureEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func featureEnvironmentFromPlan(
+	ctx context.Context,
+	data featureEnvironmentResourceModel,
+) (growthbookapi.FeatureEnvironmentConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var rules []featureRuleModel
+	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
+		diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	}
+	cfg := growthbookapi.FeatureEnvironmentConfig{
+		Enabled: data.Enabled.ValueBool(),
+		Rules:   rulesToAPI(rules),
+	}
+	if !data.DefaultValue.IsUnknown() {
+		cfg.DefaultValue = data.DefaultValue.ValueString()
+	}
+	return cfg, diags
+}
+
+func featureEnvironmentToModel(
+	ctx context.Context,
+	m *featureEnvironmentResourceModel,
+	env growthbookapi.FeatureEnvironmentConfig,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(m.FeatureID.ValueString() + "/" + m.Environment.ValueString())
+	m.Enabled = types.BoolValue(env.Enabled)
+	m.DefaultValue = types.StringValue(env.DefaultValue)
+	m.Rules, diags = types.ListValueFrom(ctx, featureRuleObjectType(), rulesFromAPI(env.Rules))
+	return diags
+}
+
+// keepFeatureEnvironmentValues keeps the default value and rule values of prior in m where they serve the same value,
+// see keepFeatureValue.
+func keepFeatureEnvironmentValues(
+	ctx context.Context,
+	valueType string,
+	prior featureEnvironmentResourceModel,
+	m *featureEnvironmentResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.DefaultValue = keepFeatureValue(valueType, prior.DefaultValue, m.DefaultValue)
+	if prior.Rules.IsNull() || prior.Rules.IsUnknown() {
+		return diags
+	}
+
+	var priorRules, rules []featureRuleModel
+	diags.Append(prior.Rules.ElementsAs(ctx, &priorRules, false)...)
+	diags.Append(m.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	keepFeatureRuleValues(valueType, priorRules, rules)
+	var d diag.Diagnostics
+	m.Rules, d = types.ListValueFrom(ctx, featureRuleObjectType(), rules)
+	diags.Append(d...)
+	return diags
+}
+
+// checkFeatureEnvironmentValues checks the values of an environment against the value type of its feature.
+func checkFeatureEnvironmentValues(valueType string, env growthbookapi.FeatureEnvironmentConfig) error {
+	if env.DefaultValue != "" {
+		if err := validateFeatureValue(valueType, env.DefaultValue); err != nil {
+			return fmt.Errorf("default_value: %w", err)
+		}
+	}
+	for i, rule := range env.Rules {
+		if err := checkFeatureRuleValue(valueType, rule); err != nil {
+			return fmt.Errorf("rules[%d].%w", i, err)
+		}
+	}
+	return nil
+}
+
+// checkFeatureRuleValue checks the value served by a force or rollout rule against the value type of its feature.
+func checkFeatureRuleValue(valueType string, rule growthbookapi.FeatureRule) error {
+	if rule.Type == "experiment-ref" {
+		return nil
+	}
+	if err := validateFeatureValue(valueType, rule.Value); err != nil {
+		return fmt.Errorf("value: %w", err)
+	}
+	return nil
+}
+
+// write checks the planned environment against its feature and stores it.
+func (r *featureEnvironmentResource) write(
+	ctx context.Context,
+	data *featureEnvironmentResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, d := r.clients.client(data.Organization)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	cfg, d := featureEnvironmentFromPlan(ctx, *data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	feature, err := client.GetFeature(ctx, data.FeatureID.ValueString())
+	if err != nil {
+		diags.AddError("Error reading feature", err.Error())
+		return diags
+	}
+	if err := checkFeatureEnvironmentValues(feature.ValueType, cfg); err != nil {
+		diags.AddError("Invalid feature value", err.Error())
+		return diags
+	}
+
+	updated, err := client.UpdateFeatureEnvironment(ctx, data.FeatureID.ValueString(), data.Environment.ValueString(), cfg)
+	if err != nil {
+		diags.AddError("Error updating feature environment", err.Error())
+		return diags
+	}
+	plan := *data
+	diags.Append(featureEnvironmentToModel(ctx, data, updated.Environments[data.Environment.ValueString()])...)
+	diags.Append(keepFeatureEnvironmentValues(ctx, feature.ValueType, plan, data)...)
+	return diags
+}
+
+func (r *featureEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data featureEnvironmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{
+		"feature_id":  data.FeatureID,
+		"environment": data.Environment,
+	})...)
+}
+
+func (r *featureEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data featureEnvironmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := client.GetFeature(ctx, data.FeatureID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature", err.Error())
+		return
+	}
+	env, ok := feature.Environments[data.Environment.ValueString()]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state := data
+	resp.Diagnostics.Append(featureEnvironmentToModel(ctx, &data, env)...)
+	resp.Diagnostics.Append(keepFeatureEnvironmentValues(ctx, feature.ValueType, state, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{
+		"feature_id":  data.FeatureID,
+		"environment": data.Environment,
+	})...)
+}
+
+func (r *featureEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data featureEnvironmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{
+		"feature_id":  data.FeatureID,
+		"environment": data.Environment,
+	})...)
+}
+
+// Delete disables the environment and removes its rules, since GrowthBook keeps a configuration for every
+// environment of a feature.
+func (r *featureEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data featureEnvironmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := client.UpdateFeatureEnvironment(ctx, data.FeatureID.ValueString(), data.Environment.ValueString(),
+		growthbookapi.FeatureEnvironmentConfig{Enabled: false})
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting feature environment", err.Error())
+	}
+}
+
+func (r *featureEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(rejectDraftMode(ctx, r.clients, req, "growthbook_feature_environment", true)...)
+}
+
+func (r *featureEnvironmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"feature_id":   identityschema.StringAttribute{RequiredForImport: true},
+			"environment":  identityschema.StringAttribute{RequiredForImport: true},
+			"organization": organizationIdentityAttribute(),
+		},
+	}
+}
+
+func (r *featureEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompositeID(ctx, req, resp, r.clients, "feature_id", "environment")
+}
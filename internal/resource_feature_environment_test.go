@@ -0,0 +1,84 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFeatureEnvironmentConfig(id, prodValue string) string {
+	return `
+resource "growthbook_environment" "dev" {
+  name = "` + id + `-dev"
+}
+resource "growthbook_environment" "prod" {
+  name = "` + id + `-prod"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+}
+resource "growthbook_feature_environment" "dev" {
+  feature_id  = growthbook_feature.test.id
+  environment = growthbook_environment.dev.id
+  enabled     = true
+  rules = [{
+    type  = "force"
+    value = "true"
+  }]
+}
+resource "growthbook_feature_environment" "prod" {
+  feature_id  = growthbook_feature.test.id
+  environment = growthbook_environment.prod.id
+  enabled     = false
+  rules = [{
+    type      = "force"
+    value     = "` + prodValue + `"
+    condition = "{\"country\":\"US\"}"
+  }]
+}
+`
+}
+
+func TestAccGrowthBookFeatureEnvironment_basic(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureEnvironmentConfig(featureID, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature_environment.dev", "id",
+						featureID+"/"+featureID+"-dev"),
+					resource.TestCheckResourceAttr("growthbook_feature_environment.dev", "enabled", "true"),
+					resource.TestCheckResourceAttr("growthbook_feature_environment.dev", "rules.#", "1"),
+					resource.TestCheckResourceAttrSet("growthbook_feature_environment.dev", "rules.0.id"),
+					resource.TestCheckResourceAttr("growthbook_feature_environment.prod", "enabled", "false"),
+					resource.TestCheckResourceAttr("growthbook_feature_environment.prod", "rules.0.value", "true"),
+				),
+			},
+			{
+				// updating one environment leaves the other one untouched
+				Config: testAccFeatureEnvironmentConfig(featureID, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature_environment.prod", "rules.0.value", "false"),
+					resource.TestCheckResourceAttr("growthbook_feature_environment.dev", "rules.#", "1"),
+					resource.TestCheckResourceAttr("growthbook_feature_environment.dev", "rules.0.value", "true"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_feature_environment.dev",
+				ImportState:       true,
+				ImportStateId:     featureID + "/" + featureID + "-dev",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
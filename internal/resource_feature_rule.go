@@ -0,0 +1,394 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &featureRuleResource{}
+var _ resource.ResourceWithImportState = &featureRuleResource{}
+var _ resource.ResourceWithIdentity = &featureRuleResource{}
+var _ resource.ResourceWithModifyPlan = &featureRuleResource{}
+var _ resource.ResourceWithValidateConfig = &featureRuleResource{}
+
+func newFeatureRuleResource() resource.Resource {
+	return &featureRuleResource{}
+}
+
+// featureRuleResource manages a single rule of a feature environment, leaving the other rules untouched.
+type featureRuleResource struct {
+	clients *providerClients
+}
+
+type featureRuleResourceModel struct {
+	ID                  types.String                      `tfsdk:"id"`
+	FeatureID           types.String                      `tfsdk:"feature_id"`
+	Environment         types.String                      `tfsdk:"environment"`
+	Position            types.String                      `tfsdk:"position"`
+	BeforeRuleID        types.String                      `tfsdk:"before_rule_id"`
+	AfterRuleID         types.String                      `tfsdk:"after_rule_id"`
+	Type                types.String                      `tfsdk:"type"`
+	Enabled             types.Bool                        `tfsdk:"enabled"`
+	Description         types.String                      `tfsdk:"description"`
+	Condition           types.String                      `tfsdk:"condition"`
+	Value               types.String                      `tfsdk:"value"`
+	Coverage            types.Float64                     `tfsdk:"coverage"`
+	HashAttribute       types.String                      `tfsdk:"hash_attribute"`
+	ExperimentID        types.String                      `tfsdk:"experiment_id"`
+	Variations          []featureVariationModel           `tfsdk:"variations"`
+	SavedGroupTargeting []featureSavedGroupTargetingModel `tfsdk:"saved_group_targeting"`
+	Prerequisites       []featurePrereqModel              `tfsdk:"prerequisites"`
+	Organization        types.String                      `tfsdk:"organization"`
+}
+
+func (r *featureRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_rule"
+}
+
+func (r *featureRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// the rule attributes are shared with the rules of growthbook_feature and growthbook_feature_environment
+	attrs := featureRulesSchemaAttr().NestedObject.Attributes
+	attrs["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The GrowthBook rule ID.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attrs["type"] = schema.StringAttribute{
+		Required:    true,
+		Description: "One of 'force', 'rollout' or 'experiment-ref'.",
+		Validators: []validator.String{
+			stringOneOf("force", "rollout", "experiment-ref"),
+		},
+	}
+	attrs["enabled"] = schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+	}
+	attrs["feature_id"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["environment"] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["position"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Where to put the rule among the rules of the environment: 'first' or 'last' (the default).",
+		Validators: []validator.String{
+			stringOneOf(growthbookapi.RulePositionFirst, growthbookapi.RulePositionLast),
+		},
+	}
+	attrs["before_rule_id"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Put the rule right before this rule.",
+	}
+	attrs["after_rule_id"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Put the rule right after this rule.",
+	}
+
+	attrs["organization"] = organizationResourceAttribute()
+	resp.Schema = schema.Schema{
+		Description: "Manages a single targeting rule of a feature environment, leaving the other rules untouched.",
+		Attributes:  attrs,
+	}
+}
+
+func (r *featureRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func (r *featureRuleResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	// attributes are read one by one, variations and the like may not be known yet
+	var position, beforeRuleID, afterRuleID, ruleType, experimentID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &position)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("before_rule_id"), &beforeRuleID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("after_rule_id"), &afterRuleID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &ruleType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("experiment_id"), &experimentID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hints := 0
+	for _, v := range []types.String{position, beforeRuleID, afterRuleID} {
+		if !v.IsNull() {
+			hints++
+		}
+	}
+	if hints > 1 {
+		resp.Diagnostics.AddError("Conflicting rule ordering",
+			"Only one of 'position', 'before_rule_id' and 'after_rule_id' can be set.",
+		)
+	}
+	if ruleType.ValueString() == "experiment-ref" && experimentID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("experiment_id"),
+			"Missing experiment ID",
+			"'experiment_id' must be set when type is 'experiment-ref'.",
+		)
+	}
+}
+
+// rulePlacement returns the ordering hints of the rule.
+func (m featureRuleResourceModel) rulePlacement() growthbookapi.RulePlacement {
+	return growthbookapi.RulePlacement{
+		Position: m.Position.ValueString(),
+		BeforeID: m.BeforeRuleID.ValueString(),
+		AfterID:  m.AfterRuleID.ValueString(),
+	}
+}
+
+func featureRuleFromPlan(m featureRuleResourceModel) growthbookapi.FeatureRule {
+	return rulesToAPI([]featureRuleModel{{
+		ID:                  m.ID,
+		Type:                m.Type,
+		Enabled:             m.Enabled,
+		Description:         m.Description,
+		Condition:           m.Condition,
+		Value:               m.Value,
+		Coverage:            m.Coverage,
+		HashAttribute:       m.HashAttribute,
+		ExperimentID:        m.ExperimentID,
+		Variations:          m.Variations,
+		SavedGroupTargeting: m.SavedGroupTargeting,
+		Prerequisites:       m.Prerequisites,
+	}})[0]
+}
+
+// featureRuleToModel populates a featureRuleResourceModel from an API rule, keeping the ordering hints.
+func featureRuleToModel(m *featureRuleResourceModel, rule *growthbookapi.FeatureRule) {
+	rm := rulesFromAPI([]growthbookapi.FeatureRule{*rule})[0]
+	m.ID = rm.ID
+	m.Type = rm.Type
+	m.Enabled = rm.Enabled
+	m.Description = rm.Description
+	m.Condition = rm.Condition
+	m.Value = rm.Value
+	m.Coverage = rm.Coverage
+	m.HashAttribute = rm.HashAttribute
+	m.ExperimentID = rm.ExperimentID
+	m.Variations = rm.Variations
+	m.SavedGroupTargeting = rm.SavedGroupTargeting
+	m.Prerequisites = rm.Prerequisites
+}
+
+// checkValue checks the rule value against the value type of its feature, and returns that value type.
+func (r *featureRuleResource) checkValue(ctx context.Context, data featureRuleResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, d := r.clients.client(data.Organization)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	feature, err := client.GetFeature(ctx, data.FeatureID.ValueString())
+	if err != nil {
+		diags.AddError("Error reading feature", err.Error())
+		return "", diags
+	}
+	if err := checkFeatureRuleValue(feature.ValueType, featureRuleFromPlan(data)); err != nil {
+		diags.AddAttributeError(path.Root("value"), "Invalid feature value", err.Error())
+	}
+	return feature.ValueType, diags
+}
+
+func (r *featureRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data featureRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	valueType, diags := r.checkValue(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule := featureRuleFromPlan(data)
+	rule.ID = ""
+	created, err := client.CreateFeatureRule(ctx, data.FeatureID.ValueString(), data.Environment.ValueString(),
+		rule, data.rulePlacement())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating feature rule", err.Error())
+		return
+	}
+
+	plan := data
+	featureRuleToModel(&data, created)
+	data.Value = keepFeatureValue(valueType, plan.Value, data.Value)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{
+		"feature_id":  data.FeatureID,
+		"environment": data.Environment,
+		"id":          data.ID,
+	})...)
+}
+
+func (r *featureRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data featureRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the feature is read rather than the rule alone, for the value type of the rule value
+	feature, err := client.GetFeature(ctx, data.FeatureID.ValueString())
+	var rule *growthbookapi.FeatureRule
+	if err == nil {
+		rule, err = feature.Rule(data.Environment.ValueString(), data.ID.ValueString())
+	}
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature rule", err.Error())
+		return
+	}
+
+	state := data
+	featureRuleToModel(&data, rule)
+	data.Value = keepFeatureValue(feature.ValueType, state.Value, data.Value)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{
+		"feature_id":  data.FeatureID,
+		"environment": data.Environment,
+		"id":          data.ID,
+	})...)
+}
+
+func (r *featureRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data featureRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state featureRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	valueType, diags := r.checkValue(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the rule only moves when its ordering hints change
+	var at *growthbookapi.RulePlacement
+	if data.rulePlacement() != state.rulePlacement() {
+		placement := data.rulePlacement()
+		at = &placement
+	}
+
+	rule := featureRuleFromPlan(data)
+	rule.ID = state.ID.ValueString()
+	updated, err := client.UpdateFeatureRule(ctx, data.FeatureID.ValueString(), data.Environment.ValueString(),
+		rule, at)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating feature rule", err.Error())
+		return
+	}
+
+	plan := data
+	featureRuleToModel(&data, updated)
+	data.Value = keepFeatureValue(valueType, plan.Value, data.Value)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Organization, map[string]types.String{
+		"feature_id":  data.FeatureID,
+		"environment": data.Environment,
+		"id":          data.ID,
+	})...)
+}
+
+func (r *featureRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data featureRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteFeatureRule(ctx, data.FeatureID.ValueString(), data.Environment.ValueString(),
+		data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting feature rule", err.Error())
+	}
+}
+
+func (r *featureRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(rejectDraftMode(ctx, r.clients, req, "growthbook_feature_rule", true)...)
+}
+
+func (r *featureRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"feature_id":   identityschema.StringAttribute{RequiredForImport: true},
+			"environment":  identityschema.StringAttribute{RequiredForImport: true},
+			"id":           identityschema.StringAttribute{RequiredForImport: true},
+			"organization": organizationIdentityAttribute(),
+		},
+	}
+}
+
+func (r *featureRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompositeID(ctx, req, resp, r.clients, "feature_id", "environment", "id")
+}
@@ -0,0 +1,101 @@
+package internal_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccFeatureRuleConfig(id, coverage string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+}
+resource "growthbook_feature_rule" "rollout" {
+  feature_id  = growthbook_feature.test.id
+  environment = growthbook_environment.test.id
+  type        = "rollout"
+  value       = "true"
+  coverage    = ` + coverage + `
+}
+resource "growthbook_feature_rule" "force" {
+  feature_id     = growthbook_feature.test.id
+  environment    = growthbook_environment.test.id
+  before_rule_id = growthbook_feature_rule.rollout.id
+  type           = "force"
+  value          = "false"
+  condition      = "{\"country\":\"FR\"}"
+}
+`
+}
+
+func TestAccGrowthBookFeatureRule_basic(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureRuleConfig(featureID, "0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_feature_rule.rollout", "id", "fr_"),
+					resource.TestCheckResourceAttr("growthbook_feature_rule.rollout", "enabled", "true"),
+					resource.TestCheckResourceAttr("growthbook_feature_rule.rollout", "coverage", "0.5"),
+					resource.TestCheckResourceAttr("growthbook_feature_rule.force", "type", "force"),
+				),
+			},
+			{
+				Config: testAccFeatureRuleConfig(featureID, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature_rule.rollout", "coverage", "1"),
+					resource.TestCheckResourceAttr("growthbook_feature.test",
+						"environments."+featureID+"-env.rules.#", "2"),
+					resource.TestCheckResourceAttrPair("growthbook_feature.test",
+						"environments."+featureID+"-env.rules.0.id", "growthbook_feature_rule.force", "id"),
+				),
+			},
+			{
+				ResourceName: "growthbook_feature_rule.rollout",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return featureID + "/" + featureID + "-env/" +
+						s.RootModule().Resources["growthbook_feature_rule.rollout"].Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookFeatureRule_draftPublishMode(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "growthbook" {
+  publish_mode = "draft"
+}
+` + testAccFeatureRuleConfig(featureID, "0.5"),
+				ExpectError: regexp.MustCompile("Unsupported publish mode"),
+			},
+		},
+	})
+}
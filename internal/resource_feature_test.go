@@ -1,10 +1,15 @@
 package internal_test
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
+	"terraform-provider-growthbook/internal/growthbookapi"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func testAccFeatureConfig(id string) string {
@@ -101,3 +106,376 @@ func TestAccGrowthBookFeature_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceGrowthBookFeatures_filters(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc-features-")
+	config := `
+resource "growthbook_project" "test" {
+  name = "` + prefix + `-proj"
+}
+resource "growthbook_feature" "on" {
+  name          = "` + prefix + `-on"
+  owner         = "owner@example.com"
+  project       = growthbook_project.test.id
+  value_type    = "boolean"
+  default_value = "true"
+  tags          = ["acc", "on"]
+}
+resource "growthbook_feature" "off" {
+  name          = "` + prefix + `-off"
+  owner         = "owner@example.com"
+  project       = growthbook_project.test.id
+  value_type    = "boolean"
+  default_value = "false"
+  tags          = ["acc"]
+}
+data "growthbook_features" "prefix" {
+  id_prefix  = "` + prefix + `"
+  depends_on = [growthbook_feature.on, growthbook_feature.off]
+}
+data "growthbook_features" "tagged" {
+  project    = growthbook_project.test.id
+  tag        = "on"
+  depends_on = [growthbook_feature.on, growthbook_feature.off]
+}
+data "growthbook_features" "none" {
+  id_prefix  = "` + prefix + `"
+  value_type = "json"
+  depends_on = [growthbook_feature.on, growthbook_feature.off]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.growthbook_features.prefix", "features.#", "2"),
+					resource.TestCheckResourceAttr("data.growthbook_features.prefix", "features.0.id", prefix+"-off"),
+					resource.TestCheckResourceAttr("data.growthbook_features.prefix", "features.1.id", prefix+"-on"),
+					resource.TestCheckResourceAttr("data.growthbook_features.tagged", "features.#", "1"),
+					resource.TestCheckResourceAttr("data.growthbook_features.tagged", "features.0.id", prefix+"-on"),
+					resource.TestCheckResourceAttr("data.growthbook_features.tagged", "features.0.default_value", "true"),
+					resource.TestCheckResourceAttr("data.growthbook_features.none", "features.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookFeature_disappears(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+	config := `
+resource "growthbook_feature" "test" {
+  name          = "` + featureID + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckResourceDisappears("growthbook_feature.test", "id",
+					func(ctx context.Context, c growthbookapi.ClientAPI, id string) error {
+						return c.DeleteFeature(ctx, id)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("growthbook_feature.test", "id", featureID),
+			},
+		},
+	})
+}
+
+func testAccFeatureSavedGroupTargetingConfig(id, matchType string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_saved_group" "beta" {
+  name      = "` + id + `-beta"
+  type      = "condition"
+  condition = "{\"beta\":true}"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+  environments = {
+    (growthbook_environment.test.id) = {
+      enabled = true
+      rules = [{
+        type    = "force"
+        enabled = true
+        value   = "true"
+        saved_group_targeting = [{
+          match_type   = "` + matchType + `"
+          saved_groups = [growthbook_saved_group.beta.id]
+        }]
+      }]
+    }
+  }
+}
+data "growthbook_feature" "by_id" {
+  id = growthbook_feature.test.id
+}
+`
+}
+
+func TestAccGrowthBookFeature_savedGroupTargeting(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+	envKey := "environments." + featureID + "-env"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureSavedGroupTargetingConfig(featureID, "any"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature.test",
+						envKey+".rules.0.saved_group_targeting.#", "1"),
+					resource.TestCheckResourceAttr("growthbook_feature.test",
+						envKey+".rules.0.saved_group_targeting.0.match_type", "any"),
+					resource.TestCheckResourceAttrPair(
+						"growthbook_feature.test", envKey+".rules.0.saved_group_targeting.0.saved_groups.0",
+						"growthbook_saved_group.beta", "id",
+					),
+					resource.TestCheckResourceAttr("data.growthbook_feature.by_id",
+						envKey+".rules.0.saved_group_targeting.0.match_type", "any"),
+				),
+			},
+			{
+				Config: testAccFeatureSavedGroupTargetingConfig(featureID, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature.test",
+						envKey+".rules.0.saved_group_targeting.0.match_type", "none"),
+					resource.TestCheckResourceAttr("data.growthbook_feature.by_id",
+						envKey+".rules.0.saved_group_targeting.0.match_type", "none"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFeatureRuleConditionConfig(id, condition string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+  environments = {
+    (growthbook_environment.test.id) = {
+      enabled = true
+      rules = [{
+        type      = "force"
+        enabled   = true
+        value     = "true"
+        condition = ` + condition + `
+      }]
+    }
+  }
+}
+`
+}
+
+func TestAccGrowthBookFeature_ruleCondition(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+	envKey := "environments." + featureID + "-env"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureRuleConditionConfig(featureID,
+					`provider::growthbook::condition({ country = { "$in" = ["US", "CA"] }, age = { "$gte" = 18 } })`),
+				Check: resource.TestCheckResourceAttr("growthbook_feature.test", envKey+".rules.0.condition",
+					`{"age":{"$gte":18},"country":{"$in":["US","CA"]}}`),
+			},
+			{
+				Config:      testAccFeatureRuleConditionConfig(featureID, `"{\"country\":{\"$within\":[\"US\"]}}"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unknown operator "\$within" at country.\$within`),
+			},
+		},
+	})
+}
+
+func testAccFeatureJSONValueConfig(id, value string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "json"
+  default_value = ` + value + `
+  environments = {
+    (growthbook_environment.test.id) = {
+      enabled       = true
+      default_value = ` + value + `
+      rules = [{
+        type    = "force"
+        enabled = true
+        value   = ` + value + `
+      }]
+    }
+  }
+}
+`
+}
+
+func TestAccGrowthBookFeature_jsonValue(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureJSONValueConfig(featureID, `"{ \"b\": [1, 2], \"a\": 1.0 }"`),
+				Check: resource.TestCheckResourceAttr("growthbook_feature.test", "default_value",
+					`{ "b": [1, 2], "a": 1.0 }`),
+			},
+			{
+				// the same document, formatted differently, must not produce a diff
+				Config:   testAccFeatureJSONValueConfig(featureID, `jsonencode({ a = 1, b = [1, 2] })`),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccFeatureJSONValueConfig(featureID, `"{not json"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected valid JSON for a json feature`),
+			},
+		},
+	})
+}
+
+func testAccFeatureDraftConfig(id, publishMode, value string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+  publish_mode  = "` + publishMode + `"
+  environments = {
+    (growthbook_environment.test.id) = {
+      enabled = true
+      rules = [{
+        type    = "force"
+        enabled = true
+        value   = "` + value + `"
+      }]
+    }
+  }
+}
+data "growthbook_feature" "live" {
+  id = growthbook_feature.test.id
+}
+`
+}
+
+func TestAccGrowthBookFeature_draft(t *testing.T) {
+	t.Parallel()
+	// the fake API never publishes drafts on its own, unlike organizations without required approvals
+	testAccRequireMock(t)
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+	envKey := "environments." + featureID + "-env"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureDraftConfig(featureID, "live", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("growthbook_feature.test", "draft_version"),
+					resource.TestCheckResourceAttr("data.growthbook_feature.live", envKey+".rules.0.value", "true"),
+				),
+			},
+			{
+				// the change is staged as a draft, the live feature keeps serving the previous rule
+				Config: testAccFeatureDraftConfig(featureID, "draft", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("growthbook_feature.test", "draft_version"),
+					resource.TestCheckResourceAttr("growthbook_feature.test", envKey+".rules.0.value", "false"),
+					resource.TestCheckResourceAttr("data.growthbook_feature.live", envKey+".rules.0.value", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFeatureDraftDescriptionConfig(id, description string) string {
+	return `
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  description   = "` + description + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+  publish_mode  = "draft"
+}
+`
+}
+
+// Changes of other attributes than the default value and environments are written live, without staging a draft.
+func TestAccGrowthBookFeature_draftUnrelatedChange(t *testing.T) {
+	t.Parallel()
+	testAccRequireMock(t)
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureDraftDescriptionConfig(featureID, "first"),
+				Check:  resource.TestCheckNoResourceAttr("growthbook_feature.test", "draft_version"),
+			},
+			{
+				Config: testAccFeatureDraftDescriptionConfig(featureID, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("growthbook_feature.test", "draft_version"),
+					resource.TestCheckResourceAttr("growthbook_feature.test", "description", "second"),
+				),
+			},
+		},
+	})
+}
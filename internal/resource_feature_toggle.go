@@ -0,0 +1,240 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &featureToggleResource{}
+var _ resource.ResourceWithImportState = &featureToggleResource{}
+var _ resource.ResourceWithIdentity = &featureToggleResource{}
+var _ resource.ResourceWithModifyPlan = &featureToggleResource{}
+
+func newFeatureToggleResource() resource.Resource {
+	return &featureToggleResource{}
+}
+
+// featureToggleResource manages whether a feature is enabled in some environments, e.g. kill switches,
+// without touching its rules.
+type featureToggleResource struct {
+	clients *providerClients
+}
+
+type featureToggleModel struct {
+	ID           types.String `tfsdk:"id"`
+	FeatureID    types.String `tfsdk:"feature_id"`
+	Environments types.Map    `tfsdk:"environments"`
+	Reason       types.String `tfsdk:"reason"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+func (r *featureToggleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_toggle"
+}
+
+func (r *featureToggleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables or disables a feature in some environments with the GrowthBook toggle endpoint, " +
+			"leaving its rules and other environments untouched. Destroying the resource, or removing an environment, " +
+			"leaves the toggles as they are rather than restoring them.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"feature_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environments": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Required:    true,
+				Description: "Whether the feature is enabled, by environment ID.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reason recorded in the GrowthBook audit log when toggling.",
+			},
+		},
+	}
+}
+
+func (r *featureToggleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+// featureToggleToModel sets the toggles of the environments managed by m from the feature.
+// Environments the feature does not have anymore are dropped, so that they show up as a diff.
+func featureToggleToModel(ctx context.Context, m *featureToggleModel, f *growthbookapi.Feature) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	managed := map[string]bool{}
+	if !m.Environments.IsNull() && !m.Environments.IsUnknown() {
+		diags.Append(m.Environments.ElementsAs(ctx, &managed, false)...)
+	}
+	toggles := make(map[string]bool, len(managed))
+	for name := range managed {
+		if env, ok := f.Environments[name]; ok {
+			toggles[name] = env.Enabled
+		}
+	}
+
+	m.ID = types.StringValue(f.ID)
+	m.FeatureID = types.StringValue(f.ID)
+	var d diag.Diagnostics
+	m.Environments, d = types.MapValueFrom(ctx, types.BoolType, toggles)
+	diags.Append(d...)
+	return diags
+}
+
+func (r *featureToggleResource) toggle(ctx context.Context, data *featureToggleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, d := r.clients.client(data.Organization)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	toggles := map[string]bool{}
+	diags.Append(data.Environments.ElementsAs(ctx, &toggles, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	feature, err := client.ToggleFeature(ctx, data.FeatureID.ValueString(), toggles, data.Reason.ValueString())
+	if err != nil {
+		diags.AddError("Error toggling feature", err.Error())
+		return diags
+	}
+	diags.Append(featureToggleToModel(ctx, data, feature)...)
+	return diags
+}
+
+func (r *featureToggleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data featureToggleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.toggle(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *featureToggleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data featureToggleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feature, err := client.GetFeature(ctx, data.FeatureID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(featureToggleToModel(ctx, &data, feature)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+// Update only toggles the environments in the plan, environments removed from the configuration are left as they are.
+func (r *featureToggleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data featureToggleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.toggle(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+// Delete leaves the feature toggles as they are: removing a kill switch from Terraform must not flip it.
+func (r *featureToggleResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *featureToggleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(rejectDraftMode(ctx, r.clients, req, "growthbook_feature_toggle", false)...)
+}
+
+func (r *featureToggleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+// ImportState imports the toggles of all the environments of a feature, by feature ID or identity.
+func (r *featureToggleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req, organization := r.clients.importOrganization(ctx, req, resp)
+	client, diags := r.clients.client(organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := req.ID
+	if id == "" && req.Identity != nil {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	feature, err := client.GetFeature(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature", err.Error())
+		return
+	}
+
+	toggles := make(map[string]bool, len(feature.Environments))
+	for name, env := range feature.Environments {
+		toggles[name] = env.Enabled
+	}
+	envs, diags := types.MapValueFrom(ctx, types.BoolType, toggles)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), feature.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature_id"), feature.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environments"), envs)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, organization, types.StringValue(feature.ID))...)
+}
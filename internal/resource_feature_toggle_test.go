@@ -0,0 +1,82 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFeatureToggleConfig(id string, enabled string) string {
+	return `
+resource "growthbook_environment" "test" {
+  name = "` + id + `-env"
+}
+resource "growthbook_feature" "test" {
+  name          = "` + id + `"
+  owner         = "owner@example.com"
+  value_type    = "boolean"
+  default_value = "false"
+  environments = {
+    (growthbook_environment.test.id) = {
+      enabled = true
+      rules = [{
+        type  = "force"
+        value = "true"
+      }]
+    }
+  }
+  lifecycle {
+    ignore_changes = [environments]
+  }
+}
+resource "growthbook_feature_toggle" "test" {
+  feature_id = growthbook_feature.test.id
+  environments = {
+    (growthbook_environment.test.id) = ` + enabled + `
+  }
+  reason = "acceptance test"
+}
+data "growthbook_feature" "by_id" {
+  id = growthbook_feature_toggle.test.feature_id
+}
+`
+}
+
+func TestAccGrowthBookFeatureToggle_basic(t *testing.T) {
+	t.Parallel()
+
+	featureID := acctest.RandomWithPrefix("tf-acc-feature-")
+	envKey := "environments." + featureID + "-env"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureToggleConfig(featureID, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature_toggle.test", envKey, "false"),
+					resource.TestCheckResourceAttr("data.growthbook_feature.by_id", envKey+".enabled", "false"),
+					// the rules are left untouched
+					resource.TestCheckResourceAttr("data.growthbook_feature.by_id", envKey+".rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccFeatureToggleConfig(featureID, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_feature_toggle.test", envKey, "true"),
+					resource.TestCheckResourceAttr("data.growthbook_feature.by_id", envKey+".enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_feature_toggle.test",
+				ImportState:       true,
+				ImportStateId:     featureID,
+				ImportStateVerify: true,
+				// imports bring every environment of the feature, GrowthBook configures all of them
+				ImportStateVerifyIgnore: []string{"reason", "environments"},
+			},
+		},
+	})
+}
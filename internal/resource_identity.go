@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+// Resources identified by a single GrowthBook ID share the same identity, supported by Terraform 1.12 and later.
+// Identities let import blocks and list results refer to resources without parsing import IDs.
+// Every identity also holds the organization of the resource, null for the organization of the provider api_key,
+// since IDs are only unique within an organization.
+
+// idIdentitySchema returns an identity schema made of a single string attribute, "id" for most resources, and the
+// organization.
+func idIdentitySchema(attr string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			attr: identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"organization": organizationIdentityAttribute(),
+		},
+	}
+}
+
+// setIdentity stores the identity attributes and the organization of a resource after it was created, read or
+// updated. identity is nil when Terraform does not support resource identity.
+func setIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	organization types.String,
+	attrs map[string]types.String,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root("organization"), organization)...)
+	for name, v := range attrs {
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), v)...)
+	}
+	return diags
+}
+
+// setIDIdentity stores the identity of a resource identified by its ID.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, organization, id types.String) diag.Diagnostics {
+	return setIdentity(ctx, identity, organization, map[string]types.String{"id": id})
+}
+
+// importLookup resolves the value of a prefixed import ID, e.g. a name, to the identifier of the resource.
+type importLookup func(ctx context.Context, client *growthbookapi.Client, value string) (string, error)
+
+// importWithLookup imports a resource by identity, by identifier, or by `<prefix>:<value>` with the value resolved
+// to the identifier by lookup. attr is the state and identity attribute holding the identifier.
+// Import IDs may end with `@<organization>` to import from one of the provider organizations.
+func importWithLookup(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+	clients *providerClients,
+	attr, prefix string,
+	lookup importLookup,
+) {
+	req, organization := clients.importOrganization(ctx, req, resp)
+	if value, ok := strings.CutPrefix(req.ID, prefix+":"); ok {
+		client, diags := clients.client(organization)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id, err := lookup(ctx, client, value)
+		if errors.Is(err, growthbookapi.ErrNotFound) {
+			resp.Diagnostics.AddError("Cannot import resource", fmt.Sprintf("No object found for %q.", req.ID))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Cannot import resource", err.Error())
+			return
+		}
+		req.ID = id
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root(attr), path.Root(attr), req, resp)
+}
+
+// uniqueIDByName returns the ID of the only item called name.
+func uniqueIDByName[T any](items []T, name string, nameOf, idOf func(T) string) (string, error) {
+	var ids []string
+	for _, item := range items {
+		if nameOf(item) == name {
+			ids = append(ids, idOf(item))
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", growthbookapi.ErrNotFound
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d objects are named %q (%s), import by ID instead", len(ids), name,
+			strings.Join(ids, ", "))
+	}
+}
+
+// importCompositeID splits import IDs made of several slash separated parts, or reads them from the identity.
+// The parts are stored in the state attributes named by attrs. Import IDs may end with `@<organization>`.
+func importCompositeID(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+	clients *providerClients,
+	attrs ...string,
+) {
+	req, _ = clients.importOrganization(ctx, req, resp)
+	values := make([]string, len(attrs))
+	if req.ID == "" && req.Identity != nil {
+		for i, attr := range attrs {
+			var v types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attr), &v)...)
+			values[i] = v.ValueString()
+		}
+	} else {
+		values = strings.Split(req.ID, "/")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valid := len(values) == len(attrs)
+	for _, v := range values {
+		valid = valid && v != ""
+	}
+	if !valid {
+		resp.Diagnostics.AddError("Invalid import ID",
+			"Expected '<"+strings.Join(attrs, ">/<")+">', received: "+req.ID,
+		)
+		return
+	}
+	for i, attr := range attrs {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), values[i])...)
+	}
+}
@@ -0,0 +1,229 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &memberRoleResource{}
+var _ resource.ResourceWithImportState = &memberRoleResource{}
+var _ resource.ResourceWithIdentity = &memberRoleResource{}
+
+func newMemberRoleResource() resource.Resource {
+	return &memberRoleResource{}
+}
+
+// memberRoleResource manages the roles of an existing member. Members join through invitations or SSO, so the
+// resource neither creates nor removes them.
+type memberRoleResource struct {
+	clients *providerClients
+}
+
+type memberRoleModel struct {
+	ID           types.String `tfsdk:"id"`
+	MemberID     types.String `tfsdk:"member_id"`
+	Email        types.String `tfsdk:"email"`
+	Role         types.String `tfsdk:"role"`
+	Environments types.List   `tfsdk:"environments"`
+	ProjectRoles types.List   `tfsdk:"project_roles"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+func (r *memberRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_role"
+}
+
+func (r *memberRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"organization": organizationResourceAttribute(),
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"member_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The user ID of the member.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "The email of the member.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	maps.Copy(attrs, accessResourceAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the roles of an existing member of the organization. Destroying it leaves the member " +
+			"with their current roles.",
+		Attributes: attrs,
+	}
+}
+
+func (r *memberRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func memberRoleFromPlan(ctx context.Context, data memberRoleModel) (*growthbookapi.MemberRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	limit, envs, d := environmentsToAPI(ctx, data.Environments)
+	diags.Append(d...)
+	projectRoles, d := projectRolesToAPI(ctx, data.ProjectRoles)
+	diags.Append(d...)
+
+	return &growthbookapi.MemberRole{
+		Role:                     data.Role.ValueString(),
+		LimitAccessByEnvironment: limit,
+		Environments:             envs,
+		ProjectRoles:             projectRoles,
+	}, diags
+}
+
+// memberRoleToModel populates m from a member, keeping its organization.
+func memberRoleToModel(ctx context.Context, m *memberRoleModel, member *growthbookapi.Member) diag.Diagnostics {
+	m.ID = types.StringValue(member.ID)
+	m.MemberID = types.StringValue(member.ID)
+	m.Email = types.StringValue(member.Email)
+	m.Role = types.StringValue(member.Role)
+	m.Environments = environmentsFromAPI(ctx, member.LimitAccessByEnvironment, member.Environments)
+
+	var diags diag.Diagnostics
+	m.ProjectRoles, diags = projectRolesFromAPI(ctx, member.ProjectRoles)
+	return diags
+}
+
+// write replaces the roles of the planned member with the planned ones.
+func (r *memberRoleResource) write(ctx context.Context, data *memberRoleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, d := r.clients.client(data.Organization)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	role, d := memberRoleFromPlan(ctx, *data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	member, err := client.UpdateMemberRole(ctx, data.MemberID.ValueString(), role)
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		diags.AddAttributeError(path.Root("member_id"), "Error updating member role",
+			"No member has the user ID "+data.MemberID.ValueString()+". Invited users must join the "+
+				"organization before their roles can be managed.")
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error updating member role", err.Error())
+		return diags
+	}
+	diags.Append(memberRoleToModel(ctx, data, member)...)
+	return diags
+}
+
+func (r *memberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data memberRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *memberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data memberRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// imported states only have the ID, which is the member ID
+	member, err := client.GetMember(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading member", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(memberRoleToModel(ctx, &data, member)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *memberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data memberRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+// Delete only forgets the member: GrowthBook has no role to go back to, and removing members is left to the UI.
+func (r *memberRoleResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *memberRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *memberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "email", func(ctx context.Context, client *growthbookapi.Client, email string) (string, error) {
+		members, err := client.ListMembers(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(members, email,
+			func(m growthbookapi.Member) string { return m.Email },
+			func(m growthbookapi.Member) string { return m.ID })
+	})
+}
@@ -0,0 +1,82 @@
+package internal_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccMemberRoleConfig(memberID, role string) string {
+	return `
+resource "growthbook_member_role" "test" {
+  member_id    = "` + memberID + `"
+  role         = "` + role + `"
+  environments = ["production"]
+}
+resource "growthbook_team" "test" {
+  name    = "` + memberID + `-team"
+  role    = "readonly"
+  members = [growthbook_member_role.test.member_id]
+}
+data "growthbook_members" "all" {
+  depends_on = [growthbook_member_role.test]
+}
+`
+}
+
+// Members join GrowthBook by accepting invitations, so they are seeded in the fake API.
+func TestAccGrowthBookMemberRole_basic(t *testing.T) {
+	testAccRequireMock(t)
+	t.Parallel()
+
+	memberID := acctest.RandomWithPrefix("u_tf-acc-")
+	email := memberID + "@example.com"
+	testAccMockServer.Seed("members", map[string]any{
+		"id": memberID, "name": "Test Member", "email": email, "role": "readonly",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberRoleConfig(memberID, "engineer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_member_role.test", "id", memberID),
+					resource.TestCheckResourceAttr("growthbook_member_role.test", "email", email),
+					resource.TestCheckResourceAttr("growthbook_member_role.test", "role", "engineer"),
+					resource.TestCheckResourceAttr("growthbook_member_role.test", "environments.0", "production"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "members.0", memberID),
+					resource.TestCheckTypeSetElemNestedAttrs("data.growthbook_members.all", "members.*",
+						map[string]string{"id": memberID, "email": email, "role": "engineer"}),
+				),
+			},
+			{
+				Config: testAccMemberRoleConfig(memberID, "admin"),
+				Check:  resource.TestCheckResourceAttr("growthbook_member_role.test", "role", "admin"),
+			},
+			{
+				ResourceName:      "growthbook_member_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "growthbook_member_role.test",
+				ImportState:       true,
+				ImportStateId:     "email:" + email,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+resource "growthbook_member_role" "missing" {
+  member_id = "` + memberID + `-missing"
+  role      = "readonly"
+}
+`,
+				ExpectError: regexp.MustCompile("No member has the user ID"),
+			},
+		},
+	})
+}
@@ -0,0 +1,477 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &metricResource{}
+var _ resource.ResourceWithImportState = &metricResource{}
+var _ resource.ResourceWithValidateConfig = &metricResource{}
+var _ resource.ResourceWithIdentity = &metricResource{}
+
+func newMetricResource() resource.Resource {
+	return &metricResource{}
+}
+
+type metricResource struct {
+	clients *providerClients
+}
+
+type metricModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	DatasourceID        types.String  `tfsdk:"datasource_id"`
+	Name                types.String  `tfsdk:"name"`
+	Type                types.String  `tfsdk:"type"`
+	Description         types.String  `tfsdk:"description"`
+	Owner               types.String  `tfsdk:"owner"`
+	Tags                types.List    `tfsdk:"tags"`
+	Projects            types.List    `tfsdk:"projects"`
+	Archived            types.Bool    `tfsdk:"archived"`
+	Goal                types.String  `tfsdk:"goal"`
+	IdentifierTypes     types.List    `tfsdk:"identifier_types"`
+	ConversionSQL       types.String  `tfsdk:"conversion_sql"`
+	UserAggregationSQL  types.String  `tfsdk:"user_aggregation_sql"`
+	DenominatorMetricID types.String  `tfsdk:"denominator_metric_id"`
+	CappingType         types.String  `tfsdk:"capping_type"`
+	CappingValue        types.Float64 `tfsdk:"capping_value"`
+	WindowType          types.String  `tfsdk:"window_type"`
+	WindowDelayHours    types.Float64 `tfsdk:"window_delay_hours"`
+	WindowValue         types.Float64 `tfsdk:"window_value"`
+	WindowUnit          types.String  `tfsdk:"window_unit"`
+	DateCreated         types.String  `tfsdk:"date_created"`
+	DateUpdated         types.String  `tfsdk:"date_updated"`
+	Organization        types.String  `tfsdk:"organization"`
+}
+
+func (r *metricResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric"
+}
+
+// metricSettingsSchemaAttrs returns the capping and window attributes shared by legacy and fact metrics.
+func metricSettingsSchemaAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"capping_type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "One of 'none', 'absolute' or 'percentile'.",
+			Validators: []validator.String{
+				stringOneOf("none", "absolute", "percentile"),
+			},
+		},
+		"capping_value": schema.Float64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Cap applied to values, an absolute value or a percentile depending on capping_type.",
+		},
+		"window_type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "One of 'none', 'conversion' or 'lookback'.",
+			Validators: []validator.String{
+				stringOneOf("none", "conversion", "lookback"),
+			},
+		},
+		"window_delay_hours": schema.Float64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"window_value": schema.Float64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"window_unit": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "One of 'hours', 'days' or 'weeks'.",
+			Validators: []validator.String{
+				stringOneOf("hours", "days", "weeks"),
+			},
+		},
+	}
+}
+
+func (r *metricResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"organization": organizationResourceAttribute(),
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"datasource_id": schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"type": schema.StringAttribute{
+			Required:    true,
+			Description: "One of 'binomial', 'count', 'duration' or 'revenue'.",
+			Validators: []validator.String{
+				stringOneOf("binomial", "count", "duration", "revenue"),
+			},
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"owner": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"tags": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"projects": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"archived": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"goal": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Either 'increase' or 'decrease'.",
+			Validators: []validator.String{
+				stringOneOf("increase", "decrease"),
+			},
+		},
+		"identifier_types": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"conversion_sql": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"user_aggregation_sql": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"denominator_metric_id": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"date_created": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"date_updated": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	for k, v := range metricSettingsSchemaAttrs() {
+		attrs[k] = v
+	}
+	resp.Schema = schema.Schema{Attributes: attrs}
+}
+
+func (r *metricResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+// cappingToAPI builds capping settings from plan values, nil when no capping type is configured.
+func cappingToAPI(cappingType types.String, value types.Float64) *growthbookapi.MetricCappingSettings {
+	if cappingType.IsNull() || cappingType.IsUnknown() {
+		return nil
+	}
+	return &growthbookapi.MetricCappingSettings{
+		Type:  cappingType.ValueString(),
+		Value: value.ValueFloat64(),
+	}
+}
+
+// windowToAPI builds window settings from plan values, nil when no window type is configured.
+func windowToAPI(
+	windowType types.String,
+	delay, value types.Float64,
+	unit types.String,
+) *growthbookapi.MetricWindowSettings {
+	if windowType.IsNull() || windowType.IsUnknown() {
+		return nil
+	}
+	return &growthbookapi.MetricWindowSettings{
+		Type:        windowType.ValueString(),
+		DelayHours:  delay.ValueFloat64(),
+		WindowValue: value.ValueFloat64(),
+		WindowUnit:  unit.ValueString(),
+	}
+}
+
+// ValidateConfig rejects SQL settings without conversion_sql, which GrowthBook requires to store any of them.
+func (r *metricResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data metricModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.ConversionSQL.IsNull() {
+		return
+	}
+	for _, a := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"identifier_types", data.IdentifierTypes},
+		{"user_aggregation_sql", data.UserAggregationSQL},
+		{"denominator_metric_id", data.DenominatorMetricID},
+	} {
+		if !a.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(a.name),
+				"Missing conversion SQL",
+				"'"+a.name+"' can only be set along with 'conversion_sql'.",
+			)
+		}
+	}
+}
+
+func metricFromPlan(ctx context.Context, data metricModel) (*growthbookapi.Metric, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := listToStrings(ctx, data.Tags)
+	diags.Append(d...)
+	projects, d := listToStrings(ctx, data.Projects)
+	diags.Append(d...)
+	identifierTypes, d := listToStrings(ctx, data.IdentifierTypes)
+	diags.Append(d...)
+
+	m := &growthbookapi.Metric{
+		DatasourceID: data.DatasourceID.ValueString(),
+		Name:         data.Name.ValueString(),
+		Type:         data.Type.ValueString(),
+		Description:  data.Description.ValueString(),
+		Owner:        data.Owner.ValueString(),
+		Tags:         tags,
+		Projects:     projects,
+		Archived:     data.Archived.ValueBool(),
+		Behavior: growthbookapi.MetricBehavior{
+			Goal:            data.Goal.ValueString(),
+			CappingSettings: cappingToAPI(data.CappingType, data.CappingValue),
+			WindowSettings: windowToAPI(
+				data.WindowType, data.WindowDelayHours, data.WindowValue, data.WindowUnit,
+			),
+		},
+	}
+	if !data.ConversionSQL.IsNull() && !data.ConversionSQL.IsUnknown() {
+		m.SQL = &growthbookapi.MetricSQL{
+			IdentifierTypes:     identifierTypes,
+			ConversionSQL:       data.ConversionSQL.ValueString(),
+			UserAggregationSQL:  data.UserAggregationSQL.ValueString(),
+			DenominatorMetricID: data.DenominatorMetricID.ValueString(),
+		}
+	}
+	return m, diags
+}
+
+func metricToModel(ctx context.Context, m *growthbookapi.Metric) metricModel {
+	out := metricModel{
+		ID:                  types.StringValue(m.ID),
+		DatasourceID:        types.StringValue(m.DatasourceID),
+		Name:                types.StringValue(m.Name),
+		Type:                types.StringValue(m.Type),
+		Description:         types.StringValue(m.Description),
+		Owner:               types.StringValue(m.Owner),
+		Tags:                stringsToList(ctx, m.Tags),
+		Projects:            stringsToList(ctx, m.Projects),
+		Archived:            types.BoolValue(m.Archived),
+		Goal:                types.StringValue(m.Behavior.Goal),
+		IdentifierTypes:     stringsToList(ctx, nil),
+		ConversionSQL:       types.StringValue(""),
+		UserAggregationSQL:  types.StringValue(""),
+		DenominatorMetricID: types.StringValue(""),
+		DateCreated:         types.StringValue(m.DateCreated),
+		DateUpdated:         types.StringValue(m.DateUpdated),
+	}
+	if m.SQL != nil {
+		out.IdentifierTypes = stringsToList(ctx, m.SQL.IdentifierTypes)
+		out.ConversionSQL = types.StringValue(m.SQL.ConversionSQL)
+		out.UserAggregationSQL = types.StringValue(m.SQL.UserAggregationSQL)
+		out.DenominatorMetricID = types.StringValue(m.SQL.DenominatorMetricID)
+	}
+	out.CappingType, out.CappingValue = cappingFromAPI(m.Behavior.CappingSettings)
+	out.WindowType, out.WindowDelayHours, out.WindowValue, out.WindowUnit = windowFromAPI(m.Behavior.WindowSettings)
+	return out
+}
+
+func cappingFromAPI(c *growthbookapi.MetricCappingSettings) (types.String, types.Float64) {
+	if c == nil {
+		return types.StringValue(""), types.Float64Value(0)
+	}
+	return types.StringValue(c.Type), types.Float64Value(c.Value)
+}
+
+func windowFromAPI(w *growthbookapi.MetricWindowSettings) (types.String, types.Float64, types.Float64, types.String) {
+	if w == nil {
+		return types.StringValue(""), types.Float64Value(0), types.Float64Value(0), types.StringValue("")
+	}
+	return types.StringValue(w.Type),
+		types.Float64Value(w.DelayHours),
+		types.Float64Value(w.WindowValue),
+		types.StringValue(w.WindowUnit)
+}
+
+func (r *metricResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data metricModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metric, diags := metricFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateMetric(ctx, metric)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating metric", err.Error())
+		return
+	}
+
+	result := metricToModel(ctx, created)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *metricResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data metricModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metric, err := client.GetMetric(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading metric", err.Error())
+		return
+	}
+
+	result := metricToModel(ctx, metric)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *metricResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data metricModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state metricModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metric, diags := metricFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateMetric(ctx, state.ID.ValueString(), metric)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating metric", err.Error())
+		return
+	}
+
+	result := metricToModel(ctx, updated)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *metricResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data metricModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteMetric(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting metric", err.Error())
+	}
+}
+
+func (r *metricResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *metricResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		items, err := client.ListMetrics(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(items, name,
+			func(v growthbookapi.Metric) string { return v.Name },
+			func(v growthbookapi.Metric) string { return v.ID })
+	})
+}
@@ -0,0 +1,89 @@
+package internal_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccMetricConfig(t *testing.T, name, description string) string {
+	t.Helper()
+
+	return testAccDataSourceConfig(t, name, "") + `
+resource "growthbook_metric" "test" {
+  name          = "` + name + `"
+  datasource_id = growthbook_data_source.test.id
+  type          = "binomial"
+  description   = "` + description + `"
+  tags          = ["terraform"]
+
+  conversion_sql = "SELECT user_id, timestamp FROM purchases"
+  window_type    = "conversion"
+  window_value   = 72
+  window_unit    = "hours"
+}
+data "growthbook_metric" "by_name" {
+  name = growthbook_metric.test.name
+}
+`
+}
+
+func TestAccGrowthBookMetric_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-met-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricConfig(t, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_metric.test", "id", "met_"),
+					resource.TestCheckResourceAttr("growthbook_metric.test", "type", "binomial"),
+					resource.TestCheckResourceAttr("growthbook_metric.test", "window_type", "conversion"),
+					resource.TestCheckResourceAttr("growthbook_metric.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.growthbook_metric.by_name", "id",
+						"growthbook_metric.test", "id"),
+				),
+			},
+			{
+				Config: testAccMetricConfig(t, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_metric.test", "description", "second"),
+					resource.TestCheckResourceAttr("data.growthbook_metric.by_name", "description", "second"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_metric.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookMetric_identifierTypesWithoutSQL(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "growthbook_metric" "test" {
+  name             = "no-sql"
+  datasource_id    = "ds_123"
+  type             = "binomial"
+  identifier_types = ["user_id"]
+}
+`,
+				ExpectError: regexp.MustCompile(`'identifier_types' can only be set along with 'conversion_sql'`),
+			},
+		},
+	})
+}
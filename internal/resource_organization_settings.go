@@ -0,0 +1,341 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &organizationSettingsResource{}
+
+func newOrganizationSettingsResource() resource.Resource {
+	return &organizationSettingsResource{}
+}
+
+// organizationSettingsResource manages the settings of an organization. There is a single set of settings per
+// organization, so creating the resource takes them over and destroying it leaves them as they are. Only the settings
+// set in the configuration are managed: the others are neither written nor refreshed.
+type organizationSettingsResource struct {
+	clients *providerClients
+}
+
+type organizationSettingsModel struct {
+	ID                    types.String  `tfsdk:"id"`
+	StatsEngine           types.String  `tfsdk:"stats_engine"`
+	ConfidenceLevel       types.Float64 `tfsdk:"confidence_level"`
+	RequireReviews        types.List    `tfsdk:"require_reviews"`
+	UseStickyBucketing    types.Bool    `tfsdk:"use_sticky_bucketing"`
+	UseFallbackAttributes types.Bool    `tfsdk:"use_fallback_attributes"`
+	SecureAttributeSalt   types.String  `tfsdk:"secure_attribute_salt"`
+	FeatureKeyExample     types.String  `tfsdk:"feature_key_example"`
+	FeatureKeyRegex       types.String  `tfsdk:"feature_key_regex"`
+	Organization          types.String  `tfsdk:"organization"`
+}
+
+type requireReviewModel struct {
+	RequireReviewOn     types.Bool `tfsdk:"require_review_on"`
+	ResetReviewOnChange types.Bool `tfsdk:"reset_review_on_change"`
+	Environments        types.List `tfsdk:"environments"`
+	Projects            types.List `tfsdk:"projects"`
+}
+
+func requireReviewObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"require_review_on":      types.BoolType,
+		"reset_review_on_change": types.BoolType,
+		"environments":           types.ListType{ElemType: types.StringType},
+		"projects":               types.ListType{ElemType: types.StringType},
+	}}
+}
+
+// organizationSettingsID is the ID of the settings, which are unique per organization.
+const organizationSettingsID = "settings"
+
+func (r *organizationSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (r *organizationSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a GrowthBook organization. Only the settings set here are managed, " +
+			"removing one leaves its current value in GrowthBook. Destroying the resource leaves all settings as they are. " +
+			"The attribute schema is managed with growthbook_attribute resources instead.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stats_engine": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default stats engine of experiments, 'bayesian' or 'frequentist'.",
+				Validators: []validator.String{
+					stringOneOf("bayesian", "frequentist"),
+				},
+			},
+			"confidence_level": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The chance to win above which a variation is a winner with the bayesian engine, e.g. 0.95.",
+			},
+			"require_reviews": schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Which feature changes need an approved review before being published. The first entry " +
+					"matching the project of a feature applies.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"require_review_on": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether changes need a review. Defaults to true.",
+						},
+						"reset_review_on_change": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether changes made after an approval need a new review.",
+						},
+						"environments": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							Description: "The environments whose changes need a review. Empty means all environments.",
+						},
+						"projects": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							Description: "The projects the entry applies to. Empty means all projects.",
+						},
+					},
+				},
+			},
+			"use_sticky_bucketing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether SDKs keep users in the variation they first saw when experiments change.",
+			},
+			"use_fallback_attributes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether experiments can set a fallback attribute, used when the hash attribute is missing.",
+			},
+			"secure_attribute_salt": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The salt SDKs use to hash the values of 'secureString' attributes.",
+			},
+			"feature_key_example": schema.StringAttribute{
+				Optional:    true,
+				Description: "The example feature key shown when creating features.",
+			},
+			"feature_key_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "The regular expression new feature keys must match.",
+			},
+		},
+	}
+}
+
+func (r *organizationSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+// organizationSettingsFromPlan returns the settings set in data, leaving the others nil so they are not sent.
+func organizationSettingsFromPlan(ctx context.Context, data organizationSettingsModel) (*growthbookapi.OrganizationSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	s := &growthbookapi.OrganizationSettings{
+		StatsEngine:           data.StatsEngine.ValueStringPointer(),
+		ConfidenceLevel:       data.ConfidenceLevel.ValueFloat64Pointer(),
+		UseStickyBucketing:    data.UseStickyBucketing.ValueBoolPointer(),
+		UseFallbackAttributes: data.UseFallbackAttributes.ValueBoolPointer(),
+		SecureAttributeSalt:   data.SecureAttributeSalt.ValueStringPointer(),
+		FeatureKeyExample:     data.FeatureKeyExample.ValueStringPointer(),
+		FeatureRegexValidator: data.FeatureKeyRegex.ValueStringPointer(),
+	}
+	if !data.RequireReviews.IsNull() && !data.RequireReviews.IsUnknown() {
+		var models []requireReviewModel
+		diags.Append(data.RequireReviews.ElementsAs(ctx, &models, false)...)
+		reviews := make([]growthbookapi.RequireReview, len(models))
+		for i, m := range models {
+			envs, d := listToStrings(ctx, m.Environments)
+			diags.Append(d...)
+			projects, d := listToStrings(ctx, m.Projects)
+			diags.Append(d...)
+			reviews[i] = growthbookapi.RequireReview{
+				RequireReviewOn:     m.RequireReviewOn.ValueBool(),
+				ResetReviewOnChange: m.ResetReviewOnChange.ValueBool(),
+				Environments:        envs,
+				Projects:            projects,
+			}
+		}
+		s.RequireReviews = &reviews
+	}
+	return s, diags
+}
+
+// organizationSettingsToModel returns all the settings, with the ID and organization of data.
+func organizationSettingsToModel(
+	ctx context.Context,
+	data organizationSettingsModel,
+	s *growthbookapi.OrganizationSettings,
+) (organizationSettingsModel, diag.Diagnostics) {
+	var reviews []requireReviewModel
+	if s.RequireReviews != nil {
+		for _, r := range *s.RequireReviews {
+			reviews = append(reviews, requireReviewModel{
+				RequireReviewOn:     types.BoolValue(r.RequireReviewOn),
+				ResetReviewOnChange: types.BoolValue(r.ResetReviewOnChange),
+				Environments:        stringsToList(ctx, r.Environments),
+				Projects:            stringsToList(ctx, r.Projects),
+			})
+		}
+	}
+	requireReviews, diags := types.ListValueFrom(ctx, requireReviewObjectType(), reviews)
+
+	return organizationSettingsModel{
+		ID:                    types.StringValue(organizationSettingsID),
+		StatsEngine:           types.StringPointerValue(s.StatsEngine),
+		ConfidenceLevel:       types.Float64PointerValue(s.ConfidenceLevel),
+		RequireReviews:        requireReviews,
+		UseStickyBucketing:    types.BoolPointerValue(s.UseStickyBucketing),
+		UseFallbackAttributes: types.BoolPointerValue(s.UseFallbackAttributes),
+		SecureAttributeSalt:   types.StringPointerValue(s.SecureAttributeSalt),
+		FeatureKeyExample:     types.StringPointerValue(s.FeatureKeyExample),
+		FeatureKeyRegex:       types.StringPointerValue(s.FeatureRegexValidator),
+		Organization:          data.Organization,
+	}, diags
+}
+
+// managedSettings keeps the settings of all that are managed, i.e. not null, in data.
+func managedSettings(data, all organizationSettingsModel) organizationSettingsModel {
+	data.ID = all.ID
+	if !data.StatsEngine.IsNull() {
+		data.StatsEngine = all.StatsEngine
+	}
+	if !data.ConfidenceLevel.IsNull() {
+		data.ConfidenceLevel = all.ConfidenceLevel
+	}
+	if !data.RequireReviews.IsNull() {
+		data.RequireReviews = all.RequireReviews
+	}
+	if !data.UseStickyBucketing.IsNull() {
+		data.UseStickyBucketing = all.UseStickyBucketing
+	}
+	if !data.UseFallbackAttributes.IsNull() {
+		data.UseFallbackAttributes = all.UseFallbackAttributes
+	}
+	if !data.SecureAttributeSalt.IsNull() {
+		data.SecureAttributeSalt = all.SecureAttributeSalt
+	}
+	if !data.FeatureKeyExample.IsNull() {
+		data.FeatureKeyExample = all.FeatureKeyExample
+	}
+	if !data.FeatureKeyRegex.IsNull() {
+		data.FeatureKeyRegex = all.FeatureKeyRegex
+	}
+	return data
+}
+
+// write sends the managed settings of data and refreshes them from the response.
+func (r *organizationSettingsResource) write(ctx context.Context, data *organizationSettingsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, d := r.clients.client(data.Organization)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	settings, d := organizationSettingsFromPlan(ctx, *data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	updated, err := client.UpdateOrganizationSettings(ctx, settings)
+	if err != nil {
+		diags.AddError("Error updating organization settings", err.Error())
+		return diags
+	}
+
+	all, d := organizationSettingsToModel(ctx, *data, updated)
+	diags.Append(d...)
+	*data = managedSettings(*data, all)
+	return diags
+}
+
+func (r *organizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := client.GetOrganizationSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization settings", err.Error())
+		return
+	}
+
+	all, diags := organizationSettingsToModel(ctx, data, settings)
+	resp.Diagnostics.Append(diags...)
+	result := managedSettings(data, all)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *organizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only forgets the settings: an organization always has settings, and there are no previous values to restore.
+func (r *organizationSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
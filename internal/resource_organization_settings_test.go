@@ -0,0 +1,64 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccOrganizationSettingsConfig(settings string) string {
+	return `
+resource "growthbook_organization_settings" "test" {
+` + settings + `
+}
+data "growthbook_organization_settings" "test" {
+  depends_on = [growthbook_organization_settings.test]
+}
+`
+}
+
+// Settings apply to the whole organization, e.g. required reviews would block the feature tests running in parallel,
+// so they are only changed in the fake API.
+func TestAccGrowthBookOrganizationSettings_basic(t *testing.T) {
+	testAccRequireMock(t)
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationSettingsConfig(`
+  stats_engine      = "frequentist"
+  confidence_level  = 0.9
+  feature_key_regex = "^[a-z-]+$"
+  require_reviews = [{
+    environments = ["production"]
+  }]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_organization_settings.test", "id", "settings"),
+					resource.TestCheckResourceAttr("growthbook_organization_settings.test", "stats_engine", "frequentist"),
+					resource.TestCheckResourceAttr("growthbook_organization_settings.test", "confidence_level", "0.9"),
+					resource.TestCheckResourceAttr("growthbook_organization_settings.test", "require_reviews.0.require_review_on", "true"),
+					resource.TestCheckResourceAttr("growthbook_organization_settings.test", "require_reviews.0.projects.#", "0"),
+					resource.TestCheckNoResourceAttr("growthbook_organization_settings.test", "use_sticky_bucketing"),
+					resource.TestCheckResourceAttr("data.growthbook_organization_settings.test", "feature_key_regex", "^[a-z-]+$"),
+					resource.TestCheckResourceAttr("data.growthbook_organization_settings.test", "use_sticky_bucketing", "false"),
+				),
+			},
+			{
+				// settings removed from the configuration keep their value
+				Config: testAccOrganizationSettingsConfig(`
+  use_sticky_bucketing = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("growthbook_organization_settings.test", "stats_engine"),
+					resource.TestCheckResourceAttr("growthbook_organization_settings.test", "use_sticky_bucketing", "true"),
+					resource.TestCheckResourceAttr("data.growthbook_organization_settings.test", "stats_engine", "frequentist"),
+					resource.TestCheckResourceAttr("data.growthbook_organization_settings.test", "require_reviews.0.environments.0", "production"),
+				),
+			},
+		},
+	})
+}
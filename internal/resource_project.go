@@ -2,8 +2,8 @@ package internal
 
 import (
 	"context"
+	"errors"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,22 +15,24 @@ import (
 
 var _ resource.Resource = &projectResource{}
 var _ resource.ResourceWithImportState = &projectResource{}
+var _ resource.ResourceWithIdentity = &projectResource{}
 
 func newProjectResource() resource.Resource {
 	return &projectResource{}
 }
 
 type projectResource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type projectModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	StatsEngine types.String `tfsdk:"stats_engine"`
-	DateCreated types.String `tfsdk:"date_created"`
-	DateUpdated types.String `tfsdk:"date_updated"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	StatsEngine  types.String `tfsdk:"stats_engine"`
+	DateCreated  types.String `tfsdk:"date_created"`
+	DateUpdated  types.String `tfsdk:"date_updated"`
+	Organization types.String `tfsdk:"organization"`
 }
 
 func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,6 +42,7 @@ func (r *projectResource) Metadata(_ context.Context, req resource.MetadataReque
 func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -74,12 +77,12 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	r.client = client
+	r.clients = clients
 }
 
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -89,6 +92,12 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	project := &growthbookapi.Project{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
@@ -97,7 +106,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		project.Settings.StatsEngine = data.StatsEngine.ValueString()
 	}
 
-	created, err := r.client.CreateProject(ctx, project)
+	created, err := client.CreateProject(ctx, project)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating project", err.Error())
 		return
@@ -111,6 +120,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	data.DateUpdated = types.StringValue(created.DateUpdated)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -120,7 +130,17 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	project, err := r.client.GetProject(ctx, data.ID.ValueString())
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := client.GetProject(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading project", err.Error())
 		return
@@ -133,6 +153,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.DateUpdated = types.StringValue(project.DateUpdated)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -142,6 +163,12 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state projectModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -156,7 +183,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		project.Settings.StatsEngine = data.StatsEngine.ValueString()
 	}
 
-	updated, err := r.client.UpdateProject(ctx, state.ID.ValueString(), project)
+	updated, err := client.UpdateProject(ctx, state.ID.ValueString(), project)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating project", err.Error())
 		return
@@ -170,6 +197,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	data.DateUpdated = types.StringValue(updated.DateUpdated)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -179,11 +207,28 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if err := r.client.DeleteProject(ctx, data.ID.ValueString()); err != nil {
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteProject(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
 		resp.Diagnostics.AddError("Error deleting project", err.Error())
 	}
 }
 
+func (r *projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		p, err := client.FindProjectByName(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		return p.ID, nil
+	})
 }
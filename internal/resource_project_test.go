@@ -1,12 +1,21 @@
 package internal_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
+	"terraform-provider-growthbook/internal/growthbookapi"
+	"terraform-provider-growthbook/internal/growthbookapi/apitest"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDataSourceGrowthBookProject_basic(t *testing.T) {
@@ -39,6 +48,32 @@ data "growthbook_project" "by_name" {
 }
 
 // generateManyProjectsHCL generates HCL for N projects with unique names and descriptions.
+func TestAccDataSourceGrowthBookProjects_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-proj-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "growthbook_project" "test" {
+  name        = "` + name + `"
+  description = "listed"
+}
+data "growthbook_projects" "all" {
+  depends_on = [growthbook_project.test]
+}
+`,
+				Check: resource.TestCheckTypeSetElemNestedAttrs("data.growthbook_projects.all", "projects.*",
+					map[string]string{"name": name, "description": "listed"}),
+			},
+		},
+	})
+}
+
 func generateManyProjectsHCL(prefix string, n int) string {
 	var b strings.Builder
 	b.Grow(n * 150)
@@ -92,3 +127,205 @@ data "growthbook_project" "by_name2" {
 		},
 	})
 }
+
+func TestAccGrowthBookProject_import(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-proj-")
+	config := `
+resource "growthbook_project" "test" {
+  name = "` + name + `"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      "growthbook_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "growthbook_project.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:    "growthbook_project.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				ResourceName:  "growthbook_project.test",
+				ImportState:   true,
+				ImportStateId: "name:" + name + "-missing",
+				ExpectError:   regexp.MustCompile("No object found"),
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookProject_disappears(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-proj-")
+	config := `
+resource "growthbook_project" "test" {
+  name = "` + name + `"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckResourceDisappears("growthbook_project.test", "id",
+					func(ctx context.Context, c growthbookapi.ClientAPI, id string) error {
+						return c.DeleteProject(ctx, id)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  testCheckResourceAttrPrefix("growthbook_project.test", "id", "prj"),
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookProject_organizations(t *testing.T) {
+	testAccRequireMock(t)
+	t.Parallel()
+
+	other := apitest.NewServer()
+	defer other.Close()
+
+	name := acctest.RandomWithPrefix("tf-acc-proj-")
+	config := `
+provider "growthbook" {
+  organizations = {
+    other = {
+      api_key = "` + other.APIKey + `"
+      api_url = "` + other.APIURL() + `"
+    }
+  }
+}
+resource "growthbook_project" "default" {
+  name = "` + name + `"
+}
+resource "growthbook_project" "other" {
+  organization = "other"
+  name         = "` + name + `"
+}
+data "growthbook_projects" "other" {
+  organization = "other"
+  depends_on   = [growthbook_project.other]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("growthbook_project.default", "organization"),
+					resource.TestCheckResourceAttr("growthbook_project.other", "organization", "other"),
+					resource.TestCheckResourceAttr("data.growthbook_projects.other", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.growthbook_projects.other", "projects.0.id",
+						"growthbook_project.other", "id"),
+					resource.TestCheckResourceAttr("data.growthbook_projects.other", "projects.0.organization", "other"),
+					func(*terraform.State) error {
+						if got := len(other.Requests()); got == 0 {
+							return errors.New("no request reached the other organization")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "growthbook_project.other",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDWithOrganization("growthbook_project.other", "id", "other"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "growthbook_project.other",
+				ImportState:       true,
+				ImportStateId:     "name:" + name + "@other",
+				ImportStateVerify: true,
+			},
+			{
+				// the identity holds the organization, since IDs are only unique within an organization
+				ResourceName:    "growthbook_project.other",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Config: config + `
+resource "growthbook_project" "unknown" {
+  organization = "missing"
+  name         = "` + name + `"
+}
+`,
+				ExpectError: regexp.MustCompile("Unknown organization"),
+			},
+		},
+	})
+}
+
+// Not parallel: injected faults apply to any request reaching the fake API.
+func TestAccGrowthBookProject_transientErrors(t *testing.T) {
+	testAccRequireMock(t)
+
+	name := acctest.RandomWithPrefix("tf-acc-proj-")
+	config := `
+provider "growthbook" {
+  retry_min_backoff_ms = 1
+  retry_max_backoff_ms = 10
+}
+resource "growthbook_project" "test" {
+  name        = "` + name + `"
+  description = "created through transient errors"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccMockServer.Inject(apitest.Fault{
+						Method: "POST", Path: "/projects", Status: http.StatusServiceUnavailable, Times: 2,
+					})
+					testAccMockServer.Inject(apitest.Fault{
+						Method: "GET", Path: "/projects/", Status: http.StatusTooManyRequests, Times: 2,
+					})
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_project.test", "id", "prj_"),
+					resource.TestCheckResourceAttr("growthbook_project.test", "description",
+						"created through transient errors"),
+				),
+			},
+		},
+	})
+}
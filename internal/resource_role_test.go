@@ -0,0 +1,52 @@
+package internal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccRoleConfig(id, policies string) string {
+	return `
+resource "growthbook_role" "test" {
+  id          = "` + id + `"
+  description = "Reads everything, drafts features"
+  policies    = ` + policies + `
+}
+`
+}
+
+func TestAccGrowthBookRole_basic(t *testing.T) {
+	t.Parallel()
+
+	// custom role IDs only allow letters, digits and underscores
+	id := strings.ReplaceAll(acctest.RandomWithPrefix("tf_acc_role"), "-", "_")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig(id, `["ReadData"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_role.test", "id", id),
+					resource.TestCheckResourceAttr("growthbook_role.test", "policies.#", "1"),
+				),
+			},
+			{
+				Config: testAccRoleConfig(id, `["ReadData", "ManageFeatureDrafts"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_role.test", "policies.#", "2"),
+					resource.TestCheckResourceAttr("growthbook_role.test", "policies.1", "ManageFeatureDrafts"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,392 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &savedGroupResource{}
+var _ resource.ResourceWithImportState = &savedGroupResource{}
+var _ resource.ResourceWithIdentity = &savedGroupResource{}
+var _ resource.ResourceWithValidateConfig = &savedGroupResource{}
+
+func newSavedGroupResource() resource.Resource {
+	return &savedGroupResource{}
+}
+
+type savedGroupResource struct {
+	clients *providerClients
+}
+
+type savedGroupModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Condition    types.String `tfsdk:"condition"`
+	AttributeKey types.String `tfsdk:"attribute_key"`
+	Values       types.List   `tfsdk:"values"`
+	Owner        types.String `tfsdk:"owner"`
+	Description  types.String `tfsdk:"description"`
+	Projects     types.List   `tfsdk:"projects"`
+	DateCreated  types.String `tfsdk:"date_created"`
+	DateUpdated  types.String `tfsdk:"date_updated"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+var validSavedGroupTypes = []string{"condition", "list"}
+
+func (r *savedGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saved_group"
+}
+
+func (r *savedGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Either 'condition' (targeting condition) or 'list' (list of attribute values).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "JSON targeting condition. Required when type is 'condition'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"attribute_key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute the values are matched against. Required when type is 'list'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute values included in the group when type is 'list'. Left untouched when not " +
+					"configured, so that they can be managed in the GrowthBook UI.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"projects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Array of project IDs.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *savedGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func (r *savedGroupResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data savedGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	groupType := data.Type.ValueString()
+	if !slices.Contains(validSavedGroupTypes, groupType) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"),
+			"Invalid saved group type",
+			"Expected 'condition' | 'list', received: "+groupType,
+		)
+		return
+	}
+	if groupType == "condition" && data.Condition.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("condition"),
+			"Missing condition",
+			"'condition' must be set when type is 'condition'.",
+		)
+	}
+	if groupType == "list" && data.AttributeKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("attribute_key"),
+			"Missing attribute key",
+			"'attribute_key' must be set when type is 'list'.",
+		)
+	}
+}
+
+func savedGroupToModel(ctx context.Context, g *growthbookapi.SavedGroup) savedGroupModel {
+	return savedGroupModel{
+		ID:           types.StringValue(g.ID),
+		Name:         types.StringValue(g.Name),
+		Type:         types.StringValue(g.Type),
+		Condition:    types.StringValue(g.Condition),
+		AttributeKey: types.StringValue(g.AttributeKey),
+		Values:       stringsToList(ctx, g.Values),
+		Owner:        types.StringValue(g.Owner),
+		Description:  types.StringValue(g.Description),
+		Projects:     stringsToList(ctx, g.Projects),
+		DateCreated:  types.StringValue(g.DateCreated),
+		DateUpdated:  types.StringValue(g.DateUpdated),
+	}
+}
+
+func savedGroupFromPlan(ctx context.Context, data savedGroupModel) (*growthbookapi.SavedGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := []string{}
+	if !data.Values.IsNull() && !data.Values.IsUnknown() {
+		diags.Append(data.Values.ElementsAs(ctx, &values, false)...)
+	}
+	projects := []string{}
+	if !data.Projects.IsNull() && !data.Projects.IsUnknown() {
+		diags.Append(data.Projects.ElementsAs(ctx, &projects, false)...)
+	}
+
+	g := &growthbookapi.SavedGroup{
+		Name:         data.Name.ValueString(),
+		Type:         data.Type.ValueString(),
+		Condition:    data.Condition.ValueString(),
+		AttributeKey: data.AttributeKey.ValueString(),
+		Owner:        data.Owner.ValueString(),
+		Description:  data.Description.ValueString(),
+		Projects:     projects,
+	}
+	if g.Type == "list" {
+		g.Values = values
+	}
+	return g, diags
+}
+
+// savedGroupUpdateFromPlan builds the update body of a saved group. Unknown attributes are left out, so that GrowthBook
+// keeps their current values.
+func savedGroupUpdateFromPlan(
+	ctx context.Context,
+	data savedGroupModel,
+) (*growthbookapi.SavedGroupUpdateBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	knownString := func(v types.String) *string {
+		if v.IsUnknown() {
+			return nil
+		}
+		return v.ValueStringPointer()
+	}
+	knownList := func(v types.List) *[]string {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		ss := []string{}
+		diags.Append(v.ElementsAs(ctx, &ss, false)...)
+		return &ss
+	}
+
+	body := &growthbookapi.SavedGroupUpdateBody{
+		Name:        data.Name.ValueString(),
+		Owner:       knownString(data.Owner),
+		Description: knownString(data.Description),
+		Projects:    knownList(data.Projects),
+	}
+	switch data.Type.ValueString() {
+	case "condition":
+		body.Condition = knownString(data.Condition)
+	case "list":
+		body.Values = knownList(data.Values)
+	}
+	return body, diags
+}
+
+func (r *savedGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data savedGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := savedGroupFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateSavedGroup(ctx, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating saved group", err.Error())
+		return
+	}
+
+	result := savedGroupToModel(ctx, created)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *savedGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data savedGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := client.GetSavedGroup(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading saved group", err.Error())
+		return
+	}
+
+	result := savedGroupToModel(ctx, group)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *savedGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data savedGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state savedGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := savedGroupUpdateFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateSavedGroup(ctx, state.ID.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating saved group", err.Error())
+		return
+	}
+
+	result := savedGroupToModel(ctx, updated)
+	result.Organization = data.Organization
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
+}
+
+func (r *savedGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data savedGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteSavedGroup(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting saved group", err.Error())
+	}
+}
+
+func (r *savedGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *savedGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		items, err := client.ListSavedGroups(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(items, name,
+			func(v growthbookapi.SavedGroup) string { return v.Name },
+			func(v growthbookapi.SavedGroup) string { return v.ID })
+	})
+}
@@ -0,0 +1,128 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccSavedGroupConfig(name, values string) string {
+	return `
+resource "growthbook_attribute" "test" {
+  property = "` + name + `-attr"
+  datatype = "string"
+}
+resource "growthbook_saved_group" "list" {
+  name          = "` + name + `-list"
+  type          = "list"
+  attribute_key = growthbook_attribute.test.property
+  values        = ` + values + `
+}
+resource "growthbook_saved_group" "condition" {
+  name      = "` + name + `-condition"
+  type      = "condition"
+  condition = "{\"country\":{\"$in\":[\"US\",\"CA\"]}}"
+}
+data "growthbook_saved_group" "by_name" {
+  name = growthbook_saved_group.list.name
+}
+`
+}
+
+func TestAccGrowthBookSavedGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-sg-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSavedGroupConfig(name, `["a", "b"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_saved_group.list", "id", "grp_"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "type", "list"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "attribute_key", name+"-attr"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "values.#", "2"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.condition", "type", "condition"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.condition", "condition",
+						"{\"country\":{\"$in\":[\"US\",\"CA\"]}}"),
+					resource.TestCheckResourceAttrPair("data.growthbook_saved_group.by_name", "id",
+						"growthbook_saved_group.list", "id"),
+					resource.TestCheckResourceAttr("data.growthbook_saved_group.by_name", "values.#", "2"),
+				),
+			},
+			{
+				Config: testAccSavedGroupConfig(name, `["a", "b", "c"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "values.#", "3"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "values.2", "c"),
+					resource.TestCheckResourceAttr("data.growthbook_saved_group.by_name", "values.#", "3"),
+				),
+			},
+			{
+				ResourceName:      "growthbook_saved_group.list",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSavedGroupNameOnlyConfig(name string) string {
+	return `
+resource "growthbook_attribute" "test" {
+  property = "` + name + `-attr"
+  datatype = "string"
+}
+resource "growthbook_saved_group" "list" {
+  name          = "` + name + `"
+  type          = "list"
+  attribute_key = growthbook_attribute.test.property
+}
+`
+}
+
+// Values and description left out of the configuration are managed in the GrowthBook UI, and survive updates of the
+// other attributes.
+func TestAccGrowthBookSavedGroup_unmanagedValues(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-sg-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSavedGroupNameOnlyConfig(name),
+				Check: func(s *terraform.State) error {
+					// simulates values and a description edited in the GrowthBook UI
+					rs := s.RootModule().Resources["growthbook_saved_group.list"]
+					description := "edited in the UI"
+					_, err := testAccClient().UpdateSavedGroup(context.Background(), rs.Primary.ID,
+						&growthbookapi.SavedGroupUpdateBody{
+							Name:        name,
+							Values:      &[]string{"a", "b"},
+							Description: &description,
+						})
+					return err
+				},
+			},
+			{
+				Config: testAccSavedGroupNameOnlyConfig(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "values.#", "2"),
+					resource.TestCheckResourceAttr("growthbook_saved_group.list", "description", "edited in the UI"),
+				),
+			},
+		},
+	})
+}
@@ -1,27 +1,32 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	"terraform-provider-growthbook/internal/growthbookapi"
 )
 
 var _ resource.Resource = &sdkConnectionResource{}
 var _ resource.ResourceWithImportState = &sdkConnectionResource{}
+var _ resource.ResourceWithIdentity = &sdkConnectionResource{}
+var _ resource.ResourceWithUpgradeState = &sdkConnectionResource{}
 
 func newSDKConnectionResource() resource.Resource {
 	return &sdkConnectionResource{}
 }
 
 type sdkConnectionResource struct {
-	client *growthbookapi.Client
+	clients *providerClients
 }
 
 type sdkConnectionModel struct {
@@ -43,13 +48,14 @@ type sdkConnectionModel struct {
 	RemoteEvalEnabled           types.Bool   `tfsdk:"remote_eval_enabled"`
 	SavedGroupReferencesEnabled types.Bool   `tfsdk:"saved_group_references_enabled"`
 	// computed
-	Organization    types.String `tfsdk:"organization"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
 	Key             types.String `tfsdk:"key"`
 	ProxySigningKey types.String `tfsdk:"proxy_signing_key"`
 	SseEnabled      types.Bool   `tfsdk:"sse_enabled"`
 	EncryptionKey   types.String `tfsdk:"encryption_key"`
 	DateCreated     types.String `tfsdk:"date_created"`
 	DateUpdated     types.String `tfsdk:"date_updated"`
+	Organization    types.String `tfsdk:"organization"`
 }
 
 func (r *sdkConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +64,10 @@ func (r *sdkConnectionResource) Metadata(_ context.Context, req resource.Metadat
 
 func (r *sdkConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// version 1 renamed organization, the GrowthBook organization ID, to organization_id
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -128,7 +137,7 @@ func (r *sdkConnectionResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed: true,
 			},
 			// computed only
-			"organization": schema.StringAttribute{
+			"organization_id": schema.StringAttribute{
 				Computed: true,
 			},
 			"key": schema.StringAttribute{
@@ -163,12 +172,12 @@ func (r *sdkConnectionResource) Configure(_ context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*growthbookapi.Client)
+	clients, ok := req.ProviderData.(*providerClients)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *growthbookapi.Client")
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
 		return
 	}
-	r.client = client
+	r.clients = clients
 }
 
 func sdkConnToModel(ctx context.Context, conn *growthbookapi.SDKConnection) sdkConnectionModel {
@@ -190,7 +199,7 @@ func sdkConnToModel(ctx context.Context, conn *growthbookapi.SDKConnection) sdkC
 		HashSecureAttributes:        types.BoolValue(conn.HashSecureAttributes),
 		RemoteEvalEnabled:           types.BoolValue(conn.RemoteEvalEnabled),
 		SavedGroupReferencesEnabled: types.BoolValue(conn.SavedGroupReferencesEnabled),
-		Organization:                types.StringValue(conn.Organization),
+		OrganizationID:              types.StringValue(conn.Organization),
 		Key:                         types.StringValue(conn.Key),
 		ProxySigningKey:             types.StringValue(conn.ProxySigningKey),
 		SseEnabled:                  types.BoolValue(conn.SseEnabled),
@@ -200,6 +209,13 @@ func sdkConnToModel(ctx context.Context, conn *growthbookapi.SDKConnection) sdkC
 	}
 }
 
+// omitSecrets clears the keys of the connection, for providers configured with omit_sdk_connection_secrets.
+func (m *sdkConnectionModel) omitSecrets() {
+	m.Key = types.StringNull()
+	m.ProxySigningKey = types.StringNull()
+	m.EncryptionKey = types.StringNull()
+}
+
 func sdkConnFromPlan(ctx context.Context, data sdkConnectionModel, projects []string) *growthbookapi.SDKConnection {
 	return &growthbookapi.SDKConnection{
 		Name:                        data.Name.ValueString(),
@@ -228,6 +244,12 @@ func (r *sdkConnectionResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projects := []string{}
 	if !data.Projects.IsNull() && !data.Projects.IsUnknown() {
 		resp.Diagnostics.Append(data.Projects.ElementsAs(ctx, &projects, false)...)
@@ -236,14 +258,19 @@ func (r *sdkConnectionResource) Create(ctx context.Context, req resource.CreateR
 		}
 	}
 
-	created, err := r.client.CreateSDKConnection(ctx, sdkConnFromPlan(ctx, data, projects))
+	created, err := client.CreateSDKConnection(ctx, sdkConnFromPlan(ctx, data, projects))
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating SDK connection", err.Error())
 		return
 	}
 
 	result := sdkConnToModel(ctx, created)
+	result.Organization = data.Organization
+	if r.clients.omitSDKConnectionSecrets {
+		result.omitSecrets()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
 }
 
 func (r *sdkConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -253,14 +280,29 @@ func (r *sdkConnectionResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	conn, err := r.client.GetSDKConnection(ctx, data.ID.ValueString())
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := client.GetSDKConnection(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SDK connection", err.Error())
 		return
 	}
 
 	result := sdkConnToModel(ctx, conn)
+	result.Organization = data.Organization
+	if r.clients.omitSDKConnectionSecrets {
+		result.omitSecrets()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
 }
 
 func (r *sdkConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -270,6 +312,12 @@ func (r *sdkConnectionResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state sdkConnectionModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -284,14 +332,19 @@ func (r *sdkConnectionResource) Update(ctx context.Context, req resource.UpdateR
 		}
 	}
 
-	updated, err := r.client.UpdateSDKConnection(ctx, state.ID.ValueString(), sdkConnFromPlan(ctx, data, projects))
+	updated, err := client.UpdateSDKConnection(ctx, state.ID.ValueString(), sdkConnFromPlan(ctx, data, projects))
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating SDK connection", err.Error())
 		return
 	}
 
 	result := sdkConnToModel(ctx, updated)
+	result.Organization = data.Organization
+	if r.clients.omitSDKConnectionSecrets {
+		result.omitSecrets()
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, result.Organization, result.ID)...)
 }
 
 func (r *sdkConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -301,11 +354,56 @@ func (r *sdkConnectionResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if err := r.client.DeleteSDKConnection(ctx, data.ID.ValueString()); err != nil {
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteSDKConnection(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
 		resp.Diagnostics.AddError("Error deleting SDK connection", err.Error())
 	}
 }
 
+// UpgradeState moves the GrowthBook organization ID of version 0 states to organization_id, since organization
+// now names the provider organization of the SDK connection.
+func (r *sdkConnectionResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeSDKConnectionStateV0},
+	}
+}
+
+func upgradeSDKConnectionStateV0(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	// numbers are kept as written, e.g. large integers are not rounded through float64
+	dec := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	dec.UseNumber()
+	var state map[string]any
+	if err := dec.Decode(&state); err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade SDK connection state", err.Error())
+		return
+	}
+	state["organization_id"] = state["organization"]
+	state["organization"] = nil
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade SDK connection state", err.Error())
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+func (r *sdkConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
 func (r *sdkConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		s, err := client.FindSDKConnectionByName(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		return s.ID, nil
+	})
 }
@@ -1,12 +1,18 @@
 package internal_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"strings"
 	"testing"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
 )
 
 func testAccSDKConnectionConfig(name string) string {
@@ -30,6 +36,41 @@ data "growthbook_sdk_connection" "hh" {
 `
 }
 
+func TestAccDataSourceGrowthBookSDKConnections_filters(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-sdkconns-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSDKConnectionConfig(name) + `
+data "growthbook_sdk_connections" "go" {
+  project    = growthbook_project.test.id
+  language   = "go"
+  depends_on = [growthbook_sdk_connection.test]
+}
+data "growthbook_sdk_connections" "none" {
+  project    = growthbook_project.test.id
+  language   = "python"
+  depends_on = [growthbook_sdk_connection.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.growthbook_sdk_connections.go", "sdk_connections.#", "1"),
+					resource.TestCheckResourceAttr("data.growthbook_sdk_connections.go", "sdk_connections.0.name", name),
+					resource.TestCheckNoResourceAttr("data.growthbook_sdk_connections.go", "sdk_connections.0.key"),
+					resource.TestCheckResourceAttrPair("data.growthbook_sdk_connections.go", "sdk_connections.0.id",
+						"growthbook_sdk_connection.test", "id"),
+					resource.TestCheckResourceAttr("data.growthbook_sdk_connections.none", "sdk_connections.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGrowthBookSDKConnection_basic(t *testing.T) {
 	t.Parallel()
 
@@ -81,3 +122,93 @@ func TestAccGrowthBookSDKConnection_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccGrowthBookSDKConnection_disappears(t *testing.T) {
+	t.Parallel()
+
+	connName := acctest.RandomWithPrefix("tf-acc-sdkconn-")
+	config := `
+resource "growthbook_environment" "test" {
+  name = "` + connName + `-env"
+}
+resource "growthbook_sdk_connection" "test" {
+  name        = "` + connName + `"
+  language    = "go"
+  environment = growthbook_environment.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: testCheckResourceDisappears("growthbook_sdk_connection.test", "id",
+					func(ctx context.Context, c growthbookapi.ClientAPI, id string) error {
+						return c.DeleteSDKConnection(ctx, id)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("growthbook_sdk_connection.test", "name", connName),
+			},
+		},
+	})
+}
+
+func TestAccGrowthBookSDKConnection_ephemeralSecrets(t *testing.T) {
+	t.Parallel()
+
+	connName := acctest.RandomWithPrefix("tf-acc-sdkconn-")
+	config := `
+provider "growthbook" {
+  omit_sdk_connection_secrets = true
+}
+` + testAccSDKConnectionConfig(connName)
+	// the ephemeral resource only reads known IDs, so the connection is created first
+	secretsConfig := config + `
+ephemeral "growthbook_sdk_connection_secrets" "test" {
+  id = growthbook_sdk_connection.test.id
+}
+provider "echo" {
+  data = ephemeral.growthbook_sdk_connection_secrets.test
+}
+resource "echo" "secrets" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"growthbook": testAccProviderFactories["growthbook"],
+			"echo":       echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_sdk_connection.test", "id", "sdk_"),
+					resource.TestCheckNoResourceAttr("growthbook_sdk_connection.test", "key"),
+					resource.TestCheckNoResourceAttr("growthbook_sdk_connection.test", "encryption_key"),
+					resource.TestCheckNoResourceAttr("growthbook_sdk_connection.test", "proxy_signing_key"),
+				),
+			},
+			{
+				Config: secretsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.secrets", "data.id", "growthbook_sdk_connection.test", "id"),
+					testCheckResourceAttrPrefix("echo.secrets", "data.key", "sdk-"),
+					resource.TestCheckNoResourceAttr("growthbook_sdk_connection.test", "key"),
+					resource.TestCheckNoResourceAttr("data.growthbook_sdk_connection.hh", "key"),
+					resource.TestCheckNoResourceAttr("data.growthbook_sdk_connection.hh", "encryption_key"),
+					resource.TestCheckNoResourceAttr("data.growthbook_sdk_connection.hh", "proxy_signing_key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,292 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &sdkWebhookResource{}
+var _ resource.ResourceWithImportState = &sdkWebhookResource{}
+var _ resource.ResourceWithIdentity = &sdkWebhookResource{}
+
+func newSDKWebhookResource() resource.Resource {
+	return &sdkWebhookResource{}
+}
+
+type sdkWebhookResource struct {
+	clients *providerClients
+}
+
+type sdkWebhookModel struct {
+	ID              types.String `tfsdk:"id"`
+	SDKConnectionID types.String `tfsdk:"sdk_connection_id"`
+	Name            types.String `tfsdk:"name"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Method          types.String `tfsdk:"method"`
+	Headers         types.Map    `tfsdk:"headers"`
+	PayloadFormat   types.String `tfsdk:"payload_format"`
+	SendPayload     types.Bool   `tfsdk:"send_payload"`
+	SigningKey      types.String `tfsdk:"signing_key"`
+	DateCreated     types.String `tfsdk:"date_created"`
+	Organization    types.String `tfsdk:"organization"`
+}
+
+func (r *sdkWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdk_webhook"
+}
+
+func (r *sdkWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a webhook of a GrowthBook SDK connection, called whenever the payload of the " +
+			"connection changes, e.g. to purge a CDN or edge cache.",
+		Attributes: map[string]schema.Attribute{
+			"organization": organizationResourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sdk_connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the SDK connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL called by the webhook.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("POST"),
+				Description: "The HTTP method of calls: POST (the default), PUT, PATCH, GET, DELETE or PURGE.",
+				Validators: []validator.String{
+					stringOneOf("POST", "PUT", "PATCH", "GET", "DELETE", "PURGE"),
+				},
+			},
+			"headers": webhookHeadersAttribute(),
+			"payload_format": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("standard"),
+				Description: "The body of calls: 'standard' (the default), 'standard-no-payload', 'sdkPayload', " +
+					"'edgeConfig' or 'none'.",
+				Validators: []validator.String{
+					stringOneOf("standard", "standard-no-payload", "sdkPayload", "edgeConfig", "none"),
+				},
+			},
+			"send_payload": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether calls include the SDK payload, for receivers predating 'payload_format'.",
+			},
+			"signing_key": webhookSigningKeyAttribute(),
+			"date_created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sdkWebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func sdkWebhookFromPlan(ctx context.Context, data sdkWebhookModel) (*growthbookapi.SDKWebhook, diag.Diagnostics) {
+	headers, diags := headersToAPI(ctx, data.Headers)
+	if diags.HasError() {
+		return nil, diags
+	}
+	// GrowthBook stores the headers of SDK webhooks as JSON text
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		diags.AddAttributeError(path.Root("headers"), "Invalid headers", err.Error())
+		return nil, diags
+	}
+	return &growthbookapi.SDKWebhook{
+		Name:          data.Name.ValueString(),
+		Endpoint:      data.Endpoint.ValueString(),
+		HTTPMethod:    data.Method.ValueString(),
+		Headers:       string(encoded),
+		PayloadFormat: data.PayloadFormat.ValueString(),
+		SendPayload:   data.SendPayload.ValueBool(),
+	}, diags
+}
+
+// sdkWebhookToModel populates m from a webhook, keeping its organization.
+func sdkWebhookToModel(ctx context.Context, m *sdkWebhookModel, w *growthbookapi.SDKWebhook) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(w.ID)
+	if len(w.SDKs) != 0 {
+		m.SDKConnectionID = types.StringValue(w.SDKs[0])
+	}
+	m.Name = types.StringValue(w.Name)
+	m.Endpoint = types.StringValue(w.Endpoint)
+	m.Method = types.StringValue(w.HTTPMethod)
+	m.PayloadFormat = types.StringValue(w.PayloadFormat)
+	m.SendPayload = types.BoolValue(w.SendPayload)
+	m.SigningKey = types.StringValue(w.SigningKey)
+	m.DateCreated = types.StringValue(w.DateCreated)
+
+	headers := map[string]string{}
+	if w.Headers != "" {
+		if err := json.Unmarshal([]byte(w.Headers), &headers); err != nil {
+			diags.AddAttributeError(path.Root("headers"), "Unexpected headers",
+				"GrowthBook returned headers that are not a JSON object of strings: "+err.Error())
+			return diags
+		}
+	}
+	var d diag.Diagnostics
+	m.Headers, d = headersFromAPI(ctx, headers)
+	diags.Append(d...)
+	return diags
+}
+
+func (r *sdkWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data sdkWebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, diags := sdkWebhookFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateSDKWebhook(ctx, data.SDKConnectionID.ValueString(), webhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SDK webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(sdkWebhookToModel(ctx, &data, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *sdkWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data sdkWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := client.GetSDKWebhook(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SDK webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(sdkWebhookToModel(ctx, &data, webhook)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *sdkWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data sdkWebhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, diags := sdkWebhookFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateSDKWebhook(ctx, data.ID.ValueString(), webhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating SDK webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(sdkWebhookToModel(ctx, &data, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *sdkWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data sdkWebhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteSDKWebhook(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting SDK webhook", err.Error())
+	}
+}
+
+func (r *sdkWebhookResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *sdkWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req, _ = r.clients.importOrganization(ctx, req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,56 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccSDKWebhookConfig(name, method string) string {
+	return testAccSDKConnectionConfig(name) + `
+resource "growthbook_sdk_webhook" "test" {
+  sdk_connection_id = growthbook_sdk_connection.test.id
+  name              = "` + name + `-purge"
+  endpoint          = "https://cdn.example.com/purge"
+  method            = "` + method + `"
+  payload_format    = "none"
+  headers = {
+    Authorization = "Bearer cdn-token"
+  }
+}
+`
+}
+
+func TestAccGrowthBookSDKWebhook_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-sdkwebhook-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSDKWebhookConfig(name, "PURGE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("growthbook_sdk_webhook.test", "sdk_connection_id",
+						"growthbook_sdk_connection.test", "id"),
+					resource.TestCheckResourceAttr("growthbook_sdk_webhook.test", "method", "PURGE"),
+					resource.TestCheckResourceAttr("growthbook_sdk_webhook.test", "headers.Authorization", "Bearer cdn-token"),
+					resource.TestCheckResourceAttr("growthbook_sdk_webhook.test", "send_payload", "false"),
+					resource.TestCheckResourceAttrSet("growthbook_sdk_webhook.test", "signing_key"),
+				),
+			},
+			{
+				Config: testAccSDKWebhookConfig(name, "POST"),
+				Check:  resource.TestCheckResourceAttr("growthbook_sdk_webhook.test", "method", "POST"),
+			},
+			{
+				ResourceName:      "growthbook_sdk_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
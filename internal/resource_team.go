@@ -0,0 +1,270 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-growthbook/internal/growthbookapi"
+)
+
+var _ resource.Resource = &teamResource{}
+var _ resource.ResourceWithImportState = &teamResource{}
+var _ resource.ResourceWithIdentity = &teamResource{}
+
+func newTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+type teamResource struct {
+	clients *providerClients
+}
+
+type teamModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Role         types.String `tfsdk:"role"`
+	Environments types.List   `tfsdk:"environments"`
+	ProjectRoles types.List   `tfsdk:"project_roles"`
+	Members      types.List   `tfsdk:"members"`
+	DateCreated  types.String `tfsdk:"date_created"`
+	DateUpdated  types.String `tfsdk:"date_updated"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"organization": organizationResourceAttribute(),
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"members": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Description: "The user IDs of the members of the team.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"date_created": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"date_updated": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+	maps.Copy(attrs, accessResourceAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a GrowthBook team, whose members get the roles of the team on top of their own.",
+		Attributes:  attrs,
+	}
+}
+
+func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clients, ok := req.ProviderData.(*providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *providerClients")
+		return
+	}
+	r.clients = clients
+}
+
+func teamFromPlan(ctx context.Context, data teamModel) (*growthbookapi.Team, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	limit, envs, d := environmentsToAPI(ctx, data.Environments)
+	diags.Append(d...)
+	projectRoles, d := projectRolesToAPI(ctx, data.ProjectRoles)
+	diags.Append(d...)
+	members, d := listToStrings(ctx, data.Members)
+	diags.Append(d...)
+
+	return &growthbookapi.Team{
+		Name:                     data.Name.ValueString(),
+		Description:              data.Description.ValueString(),
+		Role:                     data.Role.ValueString(),
+		LimitAccessByEnvironment: limit,
+		Environments:             envs,
+		ProjectRoles:             projectRoles,
+		Members:                  members,
+	}, diags
+}
+
+// teamToModel populates m from a team, keeping its organization.
+func teamToModel(ctx context.Context, m *teamModel, t *growthbookapi.Team) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(t.ID)
+	m.Name = types.StringValue(t.Name)
+	m.Description = types.StringValue(t.Description)
+	m.Role = types.StringValue(t.Role)
+	m.Environments = environmentsFromAPI(ctx, t.LimitAccessByEnvironment, t.Environments)
+	m.Members = stringsToList(ctx, t.Members)
+	m.DateCreated = types.StringValue(t.DateCreated)
+	m.DateUpdated = types.StringValue(t.DateUpdated)
+
+	var d diag.Diagnostics
+	m.ProjectRoles, d = projectRolesFromAPI(ctx, t.ProjectRoles)
+	diags.Append(d...)
+	return diags
+}
+
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data teamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, diags := teamFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateTeam(ctx, team)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating team", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(teamToModel(ctx, &data, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data teamModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := client.GetTeam(ctx, data.ID.ValueString())
+	if errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading team", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(teamToModel(ctx, &data, team)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data teamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state teamModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, diags := teamFromPlan(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateTeam(ctx, state.ID.ValueString(), team)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating team", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(teamToModel(ctx, &data, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Organization, data.ID)...)
+}
+
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data teamModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.clients.client(data.Organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteTeam(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, growthbookapi.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting team", err.Error())
+	}
+}
+
+func (r *teamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("id")
+}
+
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importWithLookup(ctx, req, resp, r.clients, "id", "name", func(ctx context.Context, client *growthbookapi.Client, name string) (string, error) {
+		teams, err := client.ListTeams(ctx)
+		if err != nil {
+			return "", err
+		}
+		return uniqueIDByName(teams, name,
+			func(t growthbookapi.Team) string { return t.Name },
+			func(t growthbookapi.Team) string { return t.ID })
+	})
+}
@@ -0,0 +1,108 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccTeamConfig(name, role string) string {
+	return `
+resource "growthbook_project" "test" {
+  name = "` + name + `-proj"
+}
+resource "growthbook_team" "test" {
+  name         = "` + name + `"
+  description  = "Checkout engineers"
+  role         = "` + role + `"
+  environments = ["staging"]
+  project_roles = [{
+    project = growthbook_project.test.id
+    role    = "engineer"
+  }]
+}
+`
+}
+
+func TestAccGrowthBookTeam_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-team-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamConfig(name, "readonly"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrPrefix("growthbook_team.test", "id", "team_"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "role", "readonly"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "environments.#", "1"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "members.#", "0"),
+					resource.TestCheckResourceAttrPair("growthbook_team.test", "project_roles.0.project",
+						"growthbook_project.test", "id"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "project_roles.0.environments.#", "0"),
+				),
+			},
+			{
+				Config: testAccTeamConfig(name, "collaborator"),
+				Check:  resource.TestCheckResourceAttr("growthbook_team.test", "role", "collaborator"),
+			},
+			{
+				ResourceName:      "growthbook_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "growthbook_team.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Members left out of the configuration, e.g. synchronized from an identity provider, are kept on update.
+func TestAccGrowthBookTeam_unsetMembers(t *testing.T) {
+	testAccRequireMock(t)
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc-team-")
+	memberID := acctest.RandomWithPrefix("u_tf-acc-")
+	testAccMockServer.Seed("members", map[string]any{
+		"id": memberID, "name": "Test Member", "email": memberID + "@example.com", "role": "readonly",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "growthbook_team" "test" {
+  name    = "` + name + `"
+  role    = "readonly"
+  members = ["` + memberID + `"]
+}
+`,
+				Check: resource.TestCheckResourceAttr("growthbook_team.test", "members.0", memberID),
+			},
+			{
+				Config: `
+resource "growthbook_team" "test" {
+  name = "` + name + `-renamed"
+  role = "readonly"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("growthbook_team.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("growthbook_team.test", "members.0", memberID),
+				),
+			},
+		},
+	})
+}
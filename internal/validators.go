@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = stringOneOfValidator{}
+var _ validator.String = conditionValidator{}
+
+// stringOneOfValidator checks that a string attribute is one of a fixed set of values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator which ensures the configured value is one of values.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return "value must be one of: '" + strings.Join(v.values, "' | '") + "'"
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if !slices.Contains(v.values, value) {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid attribute value",
+			"Expected "+v.Description(ctx)+", received: "+value,
+		)
+	}
+}
+
+// conditionValidator checks that a string attribute is a GrowthBook targeting condition
+// using only known operators.
+type conditionValidator struct{}
+
+// validCondition returns a validator which parses the configured JSON condition at plan time.
+func validCondition() validator.String {
+	return conditionValidator{}
+}
+
+func (v conditionValidator) Description(_ context.Context) string {
+	return "value must be a JSON object targeting condition using GrowthBook operators"
+}
+
+func (v conditionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conditionValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parseCondition(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid targeting condition",
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookHeadersAttribute is the headers attribute of SDK and event webhooks. Headers often carry credentials, e.g.
+// the token of a CDN purge API, so they are sensitive.
+func webhookHeadersAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Sensitive:   true,
+		Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+		Description: "The HTTP headers sent with every call.",
+	}
+}
+
+// webhookSigningKeyAttribute is the signing key of SDK and event webhooks, used by receivers to verify the
+// signature of calls.
+func webhookSigningKeyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The secret GrowthBook signs calls with, to verify them in the receiver.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func headersToAPI(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
+	headers := map[string]string{}
+	if m.IsNull() || m.IsUnknown() {
+		return headers, nil
+	}
+	diags := m.ElementsAs(ctx, &headers, false)
+	return headers, diags
+}
+
+func headersFromAPI(ctx context.Context, headers map[string]string) (types.Map, diag.Diagnostics) {
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	return types.MapValueFrom(ctx, types.StringType, headers)
+}